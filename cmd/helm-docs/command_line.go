@@ -50,17 +50,39 @@ func newHelmDocsCommand(run func(cmd *cobra.Command, args []string)) (*cobra.Com
 	command.PersistentFlags().StringP("ignore-file", "i", ".helmdocsignore", "The filename to use as an ignore file to exclude chart directories")
 	command.PersistentFlags().StringP("log-level", "l", "info", logLevelUsage)
 	command.PersistentFlags().StringP("output-file", "o", "README.md", "markdown file path relative to each chart directory to which rendered documentation will be written")
+	command.PersistentFlags().String("output-format", document.MarkdownOutputFormat, fmt.Sprintf("format of the generated documentation, \"%s\" and \"%s\" render the templates while \"%s\" and \"%s\" export the documentation model to the output file with its extension replaced. Output files ending in .adoc are always rendered as AsciiDoc", document.MarkdownOutputFormat, document.AsciiDocOutputFormat, document.JSONOutputFormat, document.YAMLOutputFormat))
+	command.PersistentFlags().String("repository-output-file", "", "with a json or yaml output format, export the documentation model of all charts to this single file instead of one file per chart")
 	command.PersistentFlags().StringP("sort-values-order", "s", document.AlphaNumSortOrder, fmt.Sprintf("order in which to sort the values table (\"%s\" or \"%s\")", document.AlphaNumSortOrder, document.FileSortOrder))
 	command.PersistentFlags().StringP("sort-sections-order", "r", document.FileSortOrder, fmt.Sprintf("order in which to sort the values sections (\"%s\" or \"%s\")", document.AlphaNumSortOrder, document.FileSortOrder))
 	command.PersistentFlags().StringSliceP("template-files", "t", []string{"README.md.gotmpl"}, "gotemplate file paths relative to each chart directory from which documentation will be generated")
 	command.PersistentFlags().StringP("badge-style", "b", "flat-square", "badge style to use for charts")
 	command.PersistentFlags().StringP("values-file", "f", "values.yaml", "Path to values file")
+	command.PersistentFlags().String("values-docs-file", "values.docs.yaml", "Path to an optional values documentation file, relative to each chart directory, whose entries override values file comments")
 	command.PersistentFlags().BoolP("document-dependency-values", "u", false, "For charts with dependencies, include the dependency values in the chart values documentation")
 	command.PersistentFlags().StringSliceP("chart-to-generate", "g", []string{}, "List of charts that will have documentation generated. Comma separated, no space. Empty list - generate for all charts in chart-search-root")
 	command.PersistentFlags().BoolP("documentation-strict-mode", "x", false, "Fail the generation of docs if there are undocumented values")
 	command.PersistentFlags().StringSliceP("documentation-strict-ignore-absent", "y", []string{"service.type", "image.repository", "image.tag"}, "A comma separate values which are allowed not to be documented in strict mode")
 	command.PersistentFlags().StringSliceP("documentation-strict-ignore-absent-regex", "z", []string{".*service\\.type", ".*image\\.repository", ".*image\\.tag"}, "A comma separate values which are allowed not to be documented in strict mode")
+	command.PersistentFlags().Bool("documentation-strict-template-values", false, "Fail the generation of docs if templates use values missing from the values file, or values are used by no template or dependency. Values in the strict mode ignore lists are allowed")
+	command.PersistentFlags().Bool("render-resources", false, "render each chart offline with the values of its values file using Helm's template engine, documenting the resources it creates")
+	command.PersistentFlags().String("kube-version", "", "Kubernetes version charts are rendered for with --render-resources, Helm's default if empty")
+	command.PersistentFlags().StringSlice("api-versions", []string{}, "additional API versions available to charts rendered with --render-resources, e.g. monitoring.coreos.com/v1")
+	command.PersistentFlags().Bool("documentation-strict-rbac-wildcards", false, "Fail the generation of docs if the roles a chart creates with its default values grant wildcard verbs or resources. Renders the charts like --render-resources")
+	command.PersistentFlags().Bool("documentation-strict-chart-lock", false, "Fail the generation of docs if the lock file of a chart is out of sync with its declared dependencies, or charts vendored in its charts directory don't have the versions they're locked to")
+	command.PersistentFlags().Bool("value-anchors", false, "add an HTML anchor to the key of each value in the values tables, which the values table of contents and references between values link to")
+	command.PersistentFlags().Bool("values-used-in-column", false, "add a column to the values tables listing the templates each value is used in")
 	command.PersistentFlags().Bool("skip-version-footer", false, "if true the helm-docs version footer will not be shown in the default README template")
+	command.PersistentFlags().Bool("inject", false, "only replace the regions between <!-- helm-docs:start:name --> and <!-- helm-docs:end:name --> markers of the existing output file with the named template")
+	command.PersistentFlags().Bool("detect-manual-edits", false, "embed a hash of the generated documentation in the output file, and refuse to overwrite output files which were edited since they were generated")
+	command.PersistentFlags().Bool("force", false, "overwrite output files even if they were edited manually since they were generated")
+	command.PersistentFlags().StringSlice("languages", []string{}, "additional languages to render documentation in, each to its own output file, e.g. README.de.md for de")
+	command.PersistentFlags().String("translations-file", "helm-docs.translations.yaml", "file of translated section names and headings by language, resolved like the template files")
+	command.PersistentFlags().String("breaking-changes-base-ref", "", "git revision to compare values files against, fail if values were removed or changed type without being marked @deprecated first, or were removed without a major chart version bump")
+
+	command.AddCommand(newExportDocsCommand())
+	command.AddCommand(newSiteCommand())
+	command.AddCommand(newExampleValuesCommand())
+	command.AddCommand(newArtifactHubImagesCommand())
 
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("HELM_DOCS")
@@ -69,3 +91,48 @@ func newHelmDocsCommand(run func(cmd *cobra.Command, args []string)) (*cobra.Com
 
 	return command, err
 }
+
+func newExportDocsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-docs",
+		Short: "export the values documentation of each chart, including documentation from values file comments, to its values documentation file",
+		Args:  cobra.NoArgs,
+		Run:   exportDocs,
+	}
+}
+
+func newSiteCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "site",
+		Short: "render the documentation of every chart into a static HTML site with a searchable index page",
+		Args:  cobra.NoArgs,
+		Run:   writeSite,
+	}
+
+	command.Flags().String("output-dir", "site", "directory to which the static HTML site will be written")
+	return command
+}
+
+func newExampleValuesCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "example-values",
+		Short: "generate an example values file for each chart, listing every documented value with its description and default",
+		Args:  cobra.NoArgs,
+		Run:   writeExampleValues,
+	}
+
+	command.Flags().String("example-file", "values.example.yaml", "path of the example values file, relative to each chart directory")
+	return command
+}
+
+func newArtifactHubImagesCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "artifacthub-images",
+		Short: "update the artifacthub.io/images annotation of each chart with the images referenced by its values",
+		Args:  cobra.NoArgs,
+		Run:   syncArtifactHubImages,
+	}
+
+	command.Flags().Bool("check", false, "don't update the annotations, fail if any of them is out of date instead")
+	return command
+}
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 
@@ -17,6 +18,7 @@ import (
 
 	"github.com/norwoodj/helm-docs/pkg/document"
 	"github.com/norwoodj/helm-docs/pkg/helm"
+	"github.com/norwoodj/helm-docs/pkg/util"
 )
 
 // parallelProcessIterable runs the visitFn function on each element of the iterable, using
@@ -68,6 +70,9 @@ func getDocumentationParsingConfigFromArgs() (helm.ChartValuesDocumentationParsi
 		StrictMode:                 viper.GetBool("documentation-strict-mode"),
 		AllowedMissingValuePaths:   viper.GetStringSlice("documentation-strict-ignore-absent"),
 		AllowedMissingValueRegexps: regexps,
+		StrictTemplateValues:       viper.GetBool("documentation-strict-template-values"),
+		StrictRBACWildcards:        viper.GetBool("documentation-strict-rbac-wildcards"),
+		StrictChartLock:            viper.GetBool("documentation-strict-chart-lock"),
 	}, nil
 }
 
@@ -142,16 +147,47 @@ func getChartToGenerate(documentationInfoByChartPath map[string]helm.ChartDocume
 	return documentationInfoToGenerate
 }
 
-func writeDocumentation(chartSearchRoot string, documentationInfoByChartPath map[string]helm.ChartDocumentationInfo, dryRun bool, parallelism int) {
+func checkBreakingValuesChanges(baseRef string, documentationInfoByChartPath map[string]helm.ChartDocumentationInfo) error {
+	if err := util.VerifyGitRevision(baseRef); err != nil {
+		return err
+	}
+
+	foundBreakingChanges := false
+	for _, info := range getChartToGenerate(documentationInfoByChartPath) {
+		breakingChanges, err := helm.FindBreakingValuesChangesSince(baseRef, info.ChartDirectory)
+		if err != nil {
+			return fmt.Errorf("error comparing values of chart %s against %s: %w", info.ChartDirectory, baseRef, err)
+		}
+
+		for _, change := range breakingChanges {
+			log.Errorf("Breaking values change in chart %s: %s", info.ChartDirectory, change)
+			foundBreakingChanges = true
+		}
+	}
+
+	if foundBreakingChanges {
+		return fmt.Errorf("found breaking values changes since %s", baseRef)
+	}
+
+	return nil
+}
+
+// writeDocumentation renders the documentation of the charts to generate, failing if any of their output files wasn't
+// written because it was edited manually.
+func writeDocumentation(chartSearchRoot string, documentationInfoByChartPath map[string]helm.ChartDocumentationInfo, dryRun bool, parallelism int) error {
 	templateFiles := viper.GetStringSlice("template-files")
 	badgeStyle := viper.GetString("badge-style")
 	skipVersionFooter := viper.GetBool("skip-version-footer")
+	languages := viper.GetStringSlice("languages")
 
 	log.Debugf("Rendering from optional template files [%s]", strings.Join(templateFiles, ", "))
 
 	documentDependencyValues := viper.GetBool("document-dependency-values")
 	documentationInfoToGenerate := getChartToGenerate(documentationInfoByChartPath)
 
+	skippedCharts := make([]string, 0)
+	skippedChartsMu := &sync.Mutex{}
+
 	parallelProcessIterable(documentationInfoToGenerate, parallelism, func(elem interface{}) {
 		info := documentationInfoByChartPath[elem.(string)]
 		var err error
@@ -163,8 +199,95 @@ func writeDocumentation(chartSearchRoot string, documentationInfoByChartPath map
 				return
 			}
 		}
-		document.PrintDocumentation(info, chartSearchRoot, templateFiles, dryRun, version, badgeStyle, dependencyValues, skipVersionFooter)
+		if document.IsModelOutputFormat() {
+			document.PrintDocumentationModel(info, dryRun, version, dependencyValues)
+			return
+		}
+
+		skipped := false
+		for _, language := range append([]string{""}, languages...) {
+			err := document.PrintDocumentation(info, chartSearchRoot, templateFiles, dryRun, version, badgeStyle, dependencyValues, skipVersionFooter, language)
+			if err != nil {
+				log.Errorf("Skipping chart %s: %s", info.ChartDirectory, err)
+				skipped = true
+			}
+		}
+
+		if skipped {
+			skippedChartsMu.Lock()
+			skippedCharts = append(skippedCharts, info.ChartDirectory)
+			skippedChartsMu.Unlock()
+		}
 	})
+
+	if len(skippedCharts) > 0 {
+		sort.Strings(skippedCharts)
+		return fmt.Errorf("documentation of charts [%s] wasn't generated", strings.Join(skippedCharts, ", "))
+	}
+
+	return nil
+}
+
+// documentationModelFunc builds the documentation model of a chart, see document.GetDocumentationModel.
+type documentationModelFunc func(helm.ChartDocumentationInfo, string, []document.DependencyValues) (document.ChartDocumentationModel, error)
+
+// getDocumentationModels returns the documentation models of the charts to generate documentation for built with the
+// given function, sorted by chart directory.
+func getDocumentationModels(documentationInfoByChartPath map[string]helm.ChartDocumentationInfo, getModel documentationModelFunc) []document.ChartDocumentationModel {
+	documentDependencyValues := viper.GetBool("document-dependency-values")
+	documentationInfoToGenerate := getChartToGenerate(documentationInfoByChartPath)
+
+	chartDirectories := make([]string, 0, len(documentationInfoToGenerate))
+	for chartDirectory := range documentationInfoToGenerate {
+		chartDirectories = append(chartDirectories, chartDirectory)
+	}
+	sort.Strings(chartDirectories)
+
+	models := make([]document.ChartDocumentationModel, 0, len(chartDirectories))
+	for _, chartDirectory := range chartDirectories {
+		info := documentationInfoToGenerate[chartDirectory]
+
+		var dependencyValues []document.DependencyValues
+		if documentDependencyValues {
+			var err error
+			dependencyValues, err = document.GetDependencyValues(info, documentationInfoByChartPath)
+			if err != nil {
+				log.Warnf("Error evaluating dependency values for chart %s, skipping: %v", info.ChartDirectory, err)
+				continue
+			}
+		}
+
+		chartModel, err := getModel(info, version, dependencyValues)
+		if err != nil {
+			log.Warnf("Error generating documentation model for chart %s, skipping: %s", info.ChartDirectory, err)
+			continue
+		}
+
+		models = append(models, chartModel)
+	}
+
+	return models
+}
+
+func writeRepositoryDocumentationModel(outputFile string, documentationInfoByChartPath map[string]helm.ChartDocumentationInfo, dryRun bool) error {
+	model := document.RepositoryDocumentationModel{
+		SchemaVersion:   document.DocumentationModelSchemaVersion,
+		HelmDocsVersion: version,
+		Charts:          getDocumentationModels(documentationInfoByChartPath, document.GetDocumentationModel),
+	}
+
+	output, err := document.MarshalDocumentationModel(model, document.GetOutputFormat())
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		_, err = os.Stdout.Write(output)
+		return err
+	}
+
+	log.Infof("Exporting documentation model of %d charts to %s", len(model.Charts), outputFile)
+	return os.WriteFile(outputFile, output, 0o644)
 }
 
 func helmDocs(_ *cobra.Command, _ []string) {
@@ -173,6 +296,16 @@ func helmDocs(_ *cobra.Command, _ []string) {
 	chartSearchRoot := viper.GetString("chart-search-root")
 	dryRun := viper.GetBool("dry-run")
 
+	switch outputFormat := document.GetOutputFormat(); outputFormat {
+	case document.MarkdownOutputFormat, document.AsciiDocOutputFormat, document.JSONOutputFormat, document.YAMLOutputFormat:
+	default:
+		log.Fatalf("Invalid output format %s, must be one of %s, %s, %s or %s", outputFormat, document.MarkdownOutputFormat, document.AsciiDocOutputFormat, document.JSONOutputFormat, document.YAMLOutputFormat)
+	}
+
+	if viper.GetString("repository-output-file") != "" && !document.IsModelOutputFormat() {
+		log.Fatalf("--repository-output-file requires the %s or %s output format", document.JSONOutputFormat, document.YAMLOutputFormat)
+	}
+
 	parallelism := runtime.NumCPU() * 2
 
 	// On dry runs all output goes to stdout, and so as to not jumble things, generate serially.
@@ -185,7 +318,156 @@ func helmDocs(_ *cobra.Command, _ []string) {
 		log.Fatal(err)
 	}
 
-	writeDocumentation(chartSearchRoot, documentationInfoByChartPath, dryRun, parallelism)
+	if baseRef := viper.GetString("breaking-changes-base-ref"); baseRef != "" {
+		if err := checkBreakingValuesChanges(baseRef, documentationInfoByChartPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if outputFile := viper.GetString("repository-output-file"); outputFile != "" {
+		if err := writeRepositoryDocumentationModel(outputFile, documentationInfoByChartPath, dryRun); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := writeDocumentation(chartSearchRoot, documentationInfoByChartPath, dryRun, parallelism); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func exportDocs(_ *cobra.Command, _ []string) {
+	initializeCli()
+
+	chartSearchRoot := viper.GetString("chart-search-root")
+	dryRun := viper.GetBool("dry-run")
+	valuesDocsFile := viper.GetString("values-docs-file")
+
+	documentationInfoByChartPath, err := readDocumentationInfoByChartPath(chartSearchRoot, 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, info := range getChartToGenerate(documentationInfoByChartPath) {
+		valuesDocs, err := helm.RenderValuesDocsFile(info)
+		if err != nil {
+			log.Warnf("Error exporting values documentation for chart %s: %s", info.ChartDirectory, err)
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("# %s\n%s", filepath.Join(info.ChartDirectory, valuesDocsFile), valuesDocs)
+			continue
+		}
+
+		log.Infof("Exporting values documentation for chart %s", info.ChartDirectory)
+		if err := os.WriteFile(filepath.Join(info.ChartDirectory, valuesDocsFile), valuesDocs, 0o644); err != nil {
+			log.Warnf("Error writing values documentation file for chart %s: %s", info.ChartDirectory, err)
+		}
+	}
+}
+
+func writeSite(command *cobra.Command, _ []string) {
+	initializeCli()
+
+	chartSearchRoot := viper.GetString("chart-search-root")
+	outputDirectory, err := command.Flags().GetString("output-dir")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	documentationInfoByChartPath, err := readDocumentationInfoByChartPath(chartSearchRoot, runtime.NumCPU()*2)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	models := getDocumentationModels(documentationInfoByChartPath, document.GetSiteDocumentationModel)
+	log.Infof("Writing documentation site for %d charts to %s", len(models), outputDirectory)
+
+	if err := document.WriteSite(outputDirectory, chartSearchRoot, models, version); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeExampleValues(command *cobra.Command, _ []string) {
+	initializeCli()
+
+	chartSearchRoot := viper.GetString("chart-search-root")
+	dryRun := viper.GetBool("dry-run")
+	exampleFile, err := command.Flags().GetString("example-file")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	documentationInfoByChartPath, err := readDocumentationInfoByChartPath(chartSearchRoot, 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, info := range getChartToGenerate(documentationInfoByChartPath) {
+		exampleValues, err := document.RenderExampleValues(info)
+		if err != nil {
+			log.Warnf("Error generating example values for chart %s: %s", info.ChartDirectory, err)
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("# %s\n%s", filepath.Join(info.ChartDirectory, exampleFile), exampleValues)
+			continue
+		}
+
+		log.Infof("Writing example values for chart %s", info.ChartDirectory)
+		if err := os.WriteFile(filepath.Join(info.ChartDirectory, exampleFile), exampleValues, 0o644); err != nil {
+			log.Warnf("Error writing example values file for chart %s: %s", info.ChartDirectory, err)
+		}
+	}
+}
+
+func syncArtifactHubImages(command *cobra.Command, _ []string) {
+	initializeCli()
+
+	chartSearchRoot := viper.GetString("chart-search-root")
+	dryRun := viper.GetBool("dry-run")
+	check, err := command.Flags().GetBool("check")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	documentationInfoByChartPath, err := readDocumentationInfoByChartPath(chartSearchRoot, 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	foundStaleImages := false
+	failedCharts := make([]string, 0)
+	for _, info := range getChartToGenerate(documentationInfoByChartPath) {
+		if check {
+			for _, problem := range helm.FindStaleArtifactHubImages(info) {
+				log.Errorf("Annotation %s of chart %s is out of date: %s", helm.ArtifactHubImagesAnnotation, info.ChartDirectory, problem)
+				foundStaleImages = true
+			}
+			continue
+		}
+
+		updated, err := helm.UpdateArtifactHubImages(info, dryRun)
+		if err != nil {
+			log.Errorf("Error updating the images of chart %s: %s", info.ChartDirectory, err)
+			failedCharts = append(failedCharts, info.ChartDirectory)
+			continue
+		}
+
+		if updated {
+			log.Infof("Updated annotation %s of chart %s", helm.ArtifactHubImagesAnnotation, info.ChartDirectory)
+		}
+	}
+
+	if foundStaleImages {
+		log.Fatalf("found out of date %s annotations, run helm-docs artifacthub-images to update them", helm.ArtifactHubImagesAnnotation)
+	}
+
+	if len(failedCharts) > 0 {
+		log.Fatalf("failed to update the %s annotation of charts [%s]", helm.ArtifactHubImagesAnnotation, strings.Join(failedCharts, ", "))
+	}
 }
 
 func main() {
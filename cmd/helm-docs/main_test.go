@@ -88,10 +88,10 @@ func (v *testFlagValue) ValueType() string {
 }
 
 // copyToTempDir copies the specified readonly filesystem into a new temporary directory and returns
-// the path to the temporary directory. It fails the benchmark on any error and handles cleanup when
-// the benchmark finishes.
+// the path to the temporary directory. It fails the test or benchmark on any error and handles cleanup when
+// it finishes.
 // TODO make use of B.TempDir instead of implementing directly https://pkg.go.dev/testing#B.TempDir
-func copyToTempDir(b *testing.B, fsys fs.FS) string {
+func copyToTempDir(b testing.TB, fsys fs.FS) string {
 	// Create the temporary directory.
 	tmp, err := os.MkdirTemp("", "")
 	if err != nil {
@@ -230,3 +230,46 @@ func TestIncludesVersionFooter(t *testing.T) {
 		t.Errorf("generated documentation must contain the helm-docs version footer, got %s", doc)
 	}
 }
+
+func TestInjectWithoutMarkersFails(t *testing.T) {
+	// Copy the chart to a temporary directory, with a README lacking the markers to inject documentation between.
+	tmp := copyToTempDir(t, os.DirFS(filepath.Join("testdata", "skip-version-footer")))
+	readmePath := filepath.Join(tmp, "README.md")
+	if err := os.WriteFile(readmePath, []byte("# Hand written\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := viper.BindFlagValues(testFlagSet{
+		"chart-search-root":   tmp,
+		"template-files":      "README.md.gotmpl",
+		"values-file":         "values.yaml",
+		"output-file":         "README.md",
+		"ignore-file":         ".helmdocsignore",
+		"log-level":           "warn",
+		"sort-values-order":   document.AlphaNumSortOrder,
+		"sort-sections-order": document.AlphaNumSortOrder,
+		"inject":              true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer viper.Reset()
+
+	documentationInfoByChartPath, err := readDocumentationInfoByChartPath(tmp, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Injecting into the README must fail the run rather than skip the chart.
+	err = writeDocumentation(tmp, documentationInfoByChartPath, false, 1)
+	if err == nil || !strings.Contains(err.Error(), "wasn't generated") {
+		t.Errorf("injecting documentation without markers must fail, got %v", err)
+	}
+
+	docBytes, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(docBytes) != "# Hand written\n" {
+		t.Errorf("the README must be left unchanged, got %s", docBytes)
+	}
+}
@@ -0,0 +1,51 @@
+{{/*
+Expand the name of the chart.
+@param . -- (context) The context of the chart using the library
+@example
+{{ include "library.name" . }}
+*/}}
+{{- define "library.name" -}}
+{{- default .Chart.Name .Values.nameOverride | trunc 63 | trimSuffix "-" }}
+{{- end }}
+
+{{/*
+Create a default fully qualified app name, truncated at 63 characters because some Kubernetes name fields are limited
+to this (by the DNS naming spec). If the release name contains the chart name, it's used as the full name.
+@param . -- (context) The context of the chart using the library
+@example
+{{ include "library.fullname" . }}
+*/}}
+{{- define "library.fullname" -}}
+{{- if .Values.fullnameOverride }}
+{{- .Values.fullnameOverride | trunc 63 | trimSuffix "-" }}
+{{- else }}
+{{- $name := default .Chart.Name .Values.nameOverride }}
+{{- if contains $name .Release.Name }}
+{{- .Release.Name | trunc 63 | trimSuffix "-" }}
+{{- else }}
+{{- printf "%s-%s" .Release.Name $name | trunc 63 | trimSuffix "-" }}
+{{- end }}
+{{- end }}
+{{- end }}
+
+{{/*
+Common labels of the resources of a component.
+@param context -- (context) The context of the chart using the library
+@param component -- (string) Name of the component, added as the app.kubernetes.io/component label
+@example
+metadata:
+  labels:
+    {{- include "library.labels" (dict "context" . "component" "server") | nindent 4 }}
+*/}}
+{{- define "library.labels" -}}
+helm.sh/chart: {{ printf "%s-%s" .context.Chart.Name .context.Chart.Version | replace "+" "_" }}
+app.kubernetes.io/name: {{ include "library.name" .context }}
+app.kubernetes.io/instance: {{ .context.Release.Name }}
+app.kubernetes.io/component: {{ .component }}
+app.kubernetes.io/managed-by: {{ .context.Release.Service }}
+{{- end }}
+
+{{- define "library.selectorLabels" -}}
+app.kubernetes.io/name: {{ include "library.name" . }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+{{- end }}
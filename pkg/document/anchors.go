@@ -0,0 +1,349 @@
+package document
+
+import (
+	"fmt"
+	"html"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+	"github.com/norwoodj/helm-docs/pkg/util"
+)
+
+var anchorInvalidCharactersRegex = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+var headingAnchorInvalidCharactersRegex = regexp.MustCompile(`[^\p{L}\p{N}_ -]+`)
+var valueReferenceRegex = regexp.MustCompile(`\[\[([A-Za-z_"][^\s\[\]]*)\]\]`)
+
+// htmlReferenceFormat is the format the references between values are linked in for the HTML values tables of markdown
+// documents.
+const htmlReferenceFormat = "html"
+
+// valueReference is a link to the documentation of another value, either of the same chart or of one of its
+// dependencies. References to values which couldn't be found have no anchor.
+type valueReference struct {
+	Key string
+
+	// Path of the documentation of the dependency the value belongs to, relative to the chart. Empty for values
+	// documented along with the chart itself.
+	Path   string
+	Anchor string
+}
+
+type valuesTocSection struct {
+	Name   string
+	Anchor string
+	Keys   []valuesTocKey
+}
+
+type valuesTocKey struct {
+	Key    string
+	Anchor string
+}
+
+// valueAnchorsEnabled reports whether the rows of the values tables get anchors, which the table of contents of the
+// values and references between values link to. Without anchors these are rendered without links.
+func valueAnchorsEnabled() bool {
+	return viper.GetBool("value-anchors")
+}
+
+// getValueAnchor returns the HTML anchor of the row documenting the value with the given key.
+func getValueAnchor(key string) string {
+	return "value-" + anchorInvalidCharactersRegex.ReplaceAllString(key, "-")
+}
+
+func getSectionAnchor(name string) string {
+	return "section-" + strings.ToLower(anchorInvalidCharactersRegex.ReplaceAllString(name, "-"))
+}
+
+// valueAnchorFunc returns the template function rendering the anchor of a value row in the given format. Each anchor is
+// only rendered the first time, so that documents rendering the values in several tables don't repeat their ids.
+func valueAnchorFunc(format string) func(anchor string) string {
+	renderedAnchors := make(map[string]bool)
+
+	return func(anchor string) string {
+		if anchor == "" || renderedAnchors[anchor] {
+			return ""
+		}
+
+		renderedAnchors[anchor] = true
+		if format == AsciiDocOutputFormat {
+			return fmt.Sprintf("[[%s]]", anchor)
+		}
+
+		return fmt.Sprintf(`<a id="%s"></a>`, anchor)
+	}
+}
+
+// getHeadingAnchor returns the anchor GitHub generates for a markdown heading with the given text.
+func getHeadingAnchor(text string) string {
+	return strings.ReplaceAll(headingAnchorInvalidCharactersRegex.ReplaceAllString(strings.ToLower(text), ""), " ", "-")
+}
+
+// getDependencyDocumentationPaths returns the path of the documentation of each dependency of a chart which is
+// available locally, relative to the chart and keyed by the alias or name of the dependency.
+func getDependencyDocumentationPaths(info helm.ChartDocumentationInfo, language string) map[string]string {
+	documentationFile := util.LanguageFilePath(viper.GetString("output-file"), language)
+	paths := make(map[string]string, len(info.Dependencies))
+
+	for _, dep := range info.Dependencies {
+		var chartPath string
+		if strings.HasPrefix(dep.Repository, "file://") {
+			chartPath = filepath.ToSlash(strings.TrimPrefix(dep.Repository, "file://"))
+		} else if dep.Repository == "" {
+			chartPath = path.Join("charts", dep.Name)
+		} else {
+			continue
+		}
+
+		paths[dep.GetName()] = path.Join(chartPath, documentationFile)
+	}
+
+	return paths
+}
+
+// resolveValueReference finds the documentation of the value a reference refers to, which is either a row of the
+// chart's own values or a row in the documentation of the dependency the value belongs to, and reports whether it was
+// found. References are only linked to the documentation of the value if value anchors are enabled.
+func resolveValueReference(reference valueReference, anchorsByKey map[string]string, dependencyPaths map[string]string) (valueReference, bool) {
+	if anchor, ok := anchorsByKey[reference.Key]; ok {
+		if valueAnchorsEnabled() {
+			reference.Anchor = anchor
+		}
+
+		return reference, true
+	}
+
+	segments := helm.SplitValueKey(reference.Key)
+	if dependencyPath, ok := dependencyPaths[segments[0]]; ok && len(segments) > 1 {
+		if valueAnchorsEnabled() {
+			reference.Path = dependencyPath
+			reference.Anchor = getValueAnchor(helm.JoinValueKey(segments[1:]))
+		}
+
+		return reference, true
+	}
+
+	return reference, false
+}
+
+// setValueRowsReferences sets the anchor of every value row if value anchors are enabled, and resolves the values they refer to, both with @see
+// comments and with [[other.key]] references in their descriptions. The resolved inline references are returned keyed
+// by the key they refer to.
+func setValueRowsReferences(info helm.ChartDocumentationInfo, valueRows []valueRow, language string) map[string]valueReference {
+	anchorsByKey := make(map[string]string, len(valueRows))
+	for i, row := range valueRows {
+		anchorsByKey[row.Key] = getValueAnchor(row.Key)
+		if valueAnchorsEnabled() {
+			valueRows[i].Anchor = anchorsByKey[row.Key]
+		}
+	}
+
+	dependencyPaths := getDependencyDocumentationPaths(info, language)
+	inlineReferences := make(map[string]valueReference)
+
+	resolve := func(row valueRow, key string) valueReference {
+		reference, found := resolveValueReference(valueReference{Key: key}, anchorsByKey, dependencyPaths)
+		if !found {
+			log.Warnf("Value %s of chart %s refers to value %s, which could not be found", row.Key, info.ChartDirectory, key)
+		}
+
+		return reference
+	}
+
+	for _, row := range valueRows {
+		for j, reference := range row.See {
+			row.See[j] = resolve(row, reference.Key)
+		}
+
+		for _, description := range []string{row.Description, row.AutoDescription} {
+			for _, match := range valueReferenceRegex.FindAllStringSubmatch(description, -1) {
+				if _, ok := inlineReferences[match[1]]; !ok {
+					inlineReferences[match[1]] = resolve(row, match[1])
+				}
+			}
+		}
+	}
+
+	return inlineReferences
+}
+
+func formatValueReference(reference valueReference, format string) string {
+	if format == AsciiDocOutputFormat {
+		switch {
+		case reference.Anchor == "":
+			return fmt.Sprintf("`+%s+`", reference.Key)
+		case reference.Path == "":
+			return fmt.Sprintf("<<%s,%s>>", reference.Anchor, reference.Key)
+		default:
+			return fmt.Sprintf("link:%s#%s[%s]", reference.Path, reference.Anchor, reference.Key)
+		}
+	}
+
+	if format == MarkdownOutputFormat {
+		if reference.Anchor == "" {
+			return fmt.Sprintf("`%s`", reference.Key)
+		}
+
+		return fmt.Sprintf("[%s](%s#%s)", reference.Key, reference.Path, reference.Anchor)
+	}
+
+	// HTML tables and the site render HTML links
+	if reference.Anchor == "" {
+		return fmt.Sprintf("<code>%s</code>", html.EscapeString(reference.Key))
+	}
+
+	return fmt.Sprintf(`<a href="%s#%s">%s</a>`, reference.Path, reference.Anchor, html.EscapeString(reference.Key))
+}
+
+// linkValueReferences replaces the [[other.key]] references in the descriptions of the values with links in the given
+// format. Descriptions are plain text on the site, so the text around the references is escaped for it. For markdown,
+// the descriptions linked as HTML are kept too, for the HTML values tables.
+func linkValueReferences(templateData *chartTemplateData, format string) {
+	rowKeys := make(map[string]bool, len(templateData.Values))
+	for _, row := range templateData.Values {
+		rowKeys[row.Key] = true
+	}
+
+	resolve := func(key string, format string) valueReference {
+		if format == siteOutputFormat {
+			// The site always renders the anchors of the values of the chart, but not the documentation of dependencies
+			if rowKeys[key] {
+				return valueReference{Key: key, Anchor: getValueAnchor(key)}
+			}
+
+			return valueReference{Key: key}
+		}
+
+		reference, ok := templateData.valueReferences[key]
+		if !ok {
+			reference = valueReference{Key: key}
+		}
+
+		return reference
+	}
+
+	escape := func(text string, format string) string {
+		if format == siteOutputFormat {
+			return html.EscapeString(text)
+		}
+
+		return text
+	}
+
+	link := func(description string, format string) string {
+		var linked strings.Builder
+		last := 0
+		for _, match := range valueReferenceRegex.FindAllStringSubmatchIndex(description, -1) {
+			linked.WriteString(escape(description[last:match[0]], format))
+			linked.WriteString(formatValueReference(resolve(description[match[2]:match[3]], format), format))
+			last = match[1]
+		}
+
+		linked.WriteString(escape(description[last:], format))
+		return linked.String()
+	}
+
+	linkRows := func(valueRows []valueRow) {
+		for i := range valueRows {
+			if format == MarkdownOutputFormat {
+				valueRows[i].HtmlDescription = link(valueRows[i].Description, htmlReferenceFormat)
+				valueRows[i].HtmlAutoDescription = link(valueRows[i].AutoDescription, htmlReferenceFormat)
+			}
+
+			valueRows[i].Description = link(valueRows[i].Description, format)
+			valueRows[i].AutoDescription = link(valueRows[i].AutoDescription, format)
+		}
+	}
+
+	linkRows(templateData.Values)
+	linkRows(templateData.Sections.DefaultSection.SectionItems)
+	for _, s := range templateData.Sections.Sections {
+		linkRows(s.SectionItems)
+	}
+}
+
+func setSectionAnchors(sectionedValueRows *sections) {
+	sectionedValueRows.DefaultSection.Anchor = getHeadingAnchor(sectionedValueRows.DefaultSection.SectionName)
+
+	for i := range sectionedValueRows.Sections {
+		sectionedValueRows.Sections[i].Anchor = getHeadingAnchor(sectionedValueRows.Sections[i].SectionName)
+	}
+}
+
+// getValuesTocKeys returns the keys of the given value rows to list in the table of contents, each linking to the first
+// row nested in it. Keys are listed from the top level down to the first level not shared with rows of other sections
+// than the given one, the sections of all rows are given by their keys.
+func getValuesTocKeys(valueRows []valueRow, sectionName string, sectionsByKey map[string]string) []valuesTocKey {
+	keys := make([]valuesTocKey, 0)
+	seenKeys := make(map[string]bool)
+
+	for _, row := range valueRows {
+		segments := helm.SplitValueKey(row.Key)
+		key := segments[0]
+		for i := 1; i < len(segments) && !ownsValueKey(key, sectionName, sectionsByKey); i++ {
+			key = helm.JoinValueKey(segments[:i+1])
+		}
+
+		if seenKeys[key] {
+			continue
+		}
+
+		seenKeys[key] = true
+		keys = append(keys, valuesTocKey{Key: key, Anchor: row.Anchor})
+	}
+
+	return keys
+}
+
+// ownsValueKey reports whether all values nested in the given key belong to the given section.
+func ownsValueKey(key string, section string, sectionsByKey map[string]string) bool {
+	keySegments := helm.SplitValueKey(key)
+	for rowKey, rowSection := range sectionsByKey {
+		rowSegments := helm.SplitValueKey(rowKey)
+		if rowSection != section && len(rowSegments) >= len(keySegments) && helm.ValueKeysMatch(keySegments, rowSegments) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getValuesToc returns the table of contents of the values, which lists the keys of each section of values. Keys with
+// values in several sections are listed in each of them by the keys nested in them which belong to the section alone.
+// Values without sections are listed in a single entry without a name.
+func getValuesToc(valueRows []valueRow, sectionedValueRows sections) []valuesTocSection {
+	if len(valueRows) == 0 {
+		return nil
+	}
+
+	if len(sectionedValueRows.Sections) == 0 {
+		return []valuesTocSection{{Keys: getValuesTocKeys(valueRows, "", nil)}}
+	}
+
+	allSections := make([]section, 0, len(sectionedValueRows.Sections)+1)
+	allSections = append(allSections, sectionedValueRows.Sections...)
+	allSections = append(allSections, sectionedValueRows.DefaultSection)
+
+	sectionsByKey := make(map[string]string, len(valueRows))
+	for _, s := range allSections {
+		for _, row := range s.SectionItems {
+			sectionsByKey[row.Key] = s.SectionName
+		}
+	}
+
+	toc := make([]valuesTocSection, 0, len(allSections))
+	for _, s := range allSections {
+		if len(s.SectionItems) == 0 {
+			continue
+		}
+
+		toc = append(toc, valuesTocSection{Name: s.SectionName, Anchor: s.Anchor, Keys: getValuesTocKeys(s.SectionItems, s.SectionName, sectionsByKey)})
+	}
+
+	return toc
+}
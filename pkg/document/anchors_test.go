@@ -0,0 +1,211 @@
+package document
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func getReferencesTestTemplateData(t *testing.T, valueAnchors bool) chartTemplateData {
+	viper.Set("output-file", "README.md")
+	viper.Set("value-anchors", valueAnchors)
+	defer viper.Reset()
+
+	helmValues := parseYamlValues(`
+# -- The image, pulled with [[image.pullPolicy]]
+# @section -- Image
+image:
+  # -- The pull policy
+  # @section -- Image
+  pullPolicy: Always
+
+# -- Number of replicas
+# @see -- autoscaling.enabled
+# @see -- redis.replicas
+replicas: 1
+
+autoscaling:
+  # -- Replaces [[replicas]], see also [[unknown.key]] and [[postgresql.auth.password]]
+  enabled: false
+	`)
+
+	info := helm.ChartDocumentationInfo{
+		ChartDirectory: t.TempDir(),
+		ChartRequirements: helm.ChartRequirements{
+			Dependencies: []helm.ChartRequirementsItem{
+				{Name: "redis", Repository: "file://../redis"},
+				{Name: "postgresql", Alias: "db", Repository: "https://charts.example.com"},
+			},
+		},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+	}
+
+	data, err := getChartTemplateData(info, "", nil, true, &translator{})
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestGetValueAnchor(t *testing.T) {
+	assert.Equal(t, "value-image.tag", getValueAnchor("image.tag"))
+	assert.Equal(t, "value-hosts-0-.name", getValueAnchor("hosts[0].name"))
+	assert.Equal(t, "value-annotations.-example.com-name-", getValueAnchor(`annotations."example.com/name"`))
+}
+
+func TestGetHeadingAnchor(t *testing.T) {
+	assert.Equal(t, "other-values", getHeadingAnchor("Other Values"))
+	assert.Equal(t, "tls--ingress", getHeadingAnchor("TLS & Ingress"))
+	assert.Equal(t, "übersicht", getHeadingAnchor("Übersicht"))
+}
+
+func TestValueRowsAnchorsAndReferences(t *testing.T) {
+	data := getReferencesTestTemplateData(t, true)
+
+	require.Len(t, data.Values, 4)
+	assert.Equal(t, "autoscaling.enabled", data.Values[0].Key)
+	assert.Equal(t, "value-autoscaling.enabled", data.Values[0].Anchor)
+	assert.Equal(t, "replicas", data.Values[3].Key)
+	assert.Equal(t, []valueReference{
+		{Key: "autoscaling.enabled", Anchor: "value-autoscaling.enabled"},
+		{Key: "redis.replicas", Path: "../redis/README.md", Anchor: "value-replicas"},
+	}, data.Values[3].See)
+
+	assert.Equal(t, map[string]valueReference{
+		"image.pullPolicy":         {Key: "image.pullPolicy", Anchor: "value-image.pullPolicy"},
+		"replicas":                 {Key: "replicas", Anchor: "value-replicas"},
+		"unknown.key":              {Key: "unknown.key"},
+		"postgresql.auth.password": {Key: "postgresql.auth.password"},
+	}, data.valueReferences)
+}
+
+func TestValueAnchorsDisabled(t *testing.T) {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	data := getReferencesTestTemplateData(t, false)
+
+	assert.Equal(t, "", data.Values[0].Anchor)
+	assert.Equal(t, []valueReference{{Key: "autoscaling.enabled"}, {Key: "redis.replicas"}}, data.Values[3].See)
+	assert.Equal(t, []valuesTocKey{{Key: "image"}}, data.ValuesToc[0].Keys)
+
+	// Only the references to values which don't exist are reported
+	var warnings []string
+	for _, entry := range hook.AllEntries() {
+		if strings.Contains(entry.Message, "refers to value") {
+			warnings = append(warnings, entry.Message)
+		}
+	}
+
+	require.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "refers to value unknown.key")
+	assert.Contains(t, warnings[1], "refers to value postgresql.auth.password")
+
+	linkValueReferences(&data, MarkdownOutputFormat)
+	assert.Equal(t, "Replaces `replicas`, see also `unknown.key` and `postgresql.auth.password`", data.Values[0].AutoDescription)
+}
+
+func TestValueAnchorFunc(t *testing.T) {
+	valueAnchor := valueAnchorFunc(MarkdownOutputFormat)
+	assert.Equal(t, `<a id="value-image"></a>`, valueAnchor("value-image"))
+	assert.Equal(t, "", valueAnchor("value-image"))
+	assert.Equal(t, "", valueAnchor(""))
+
+	assert.Equal(t, "[[value-image]]", valueAnchorFunc(AsciiDocOutputFormat)("value-image"))
+}
+
+func TestLinkValueReferences(t *testing.T) {
+	data := getReferencesTestTemplateData(t, true)
+	linkValueReferences(&data, MarkdownOutputFormat)
+
+	assert.Equal(t, "Replaces [replicas](#value-replicas), see also `unknown.key` and `postgresql.auth.password`", data.Values[0].AutoDescription)
+	assert.Equal(t, "The image, pulled with [image.pullPolicy](#value-image.pullPolicy)", data.Sections.Sections[0].SectionItems[0].AutoDescription)
+
+	// The HTML values tables render the references as HTML
+	assert.Equal(t, `Replaces <a href="#value-replicas">replicas</a>, see also <code>unknown.key</code> and <code>postgresql.auth.password</code>`, data.Values[0].HtmlAutoDescription)
+	assert.Equal(t, `The image, pulled with <a href="#value-image.pullPolicy">image.pullPolicy</a>`, data.Sections.Sections[0].SectionItems[0].HtmlAutoDescription)
+
+	data = getReferencesTestTemplateData(t, true)
+	linkValueReferences(&data, AsciiDocOutputFormat)
+
+	assert.Equal(t, "Replaces <<value-replicas,replicas>>, see also `+unknown.key+` and `+postgresql.auth.password+`", data.Values[0].AutoDescription)
+	assert.Equal(t, "link:../redis/README.md#value-replicas[redis.replicas]", formatValueReference(data.Values[3].See[1], AsciiDocOutputFormat))
+}
+
+func TestGetValuesToc(t *testing.T) {
+	data := getReferencesTestTemplateData(t, true)
+
+	assert.Equal(t, []valuesTocSection{
+		{Name: "Image", Anchor: "image", Keys: []valuesTocKey{{Key: "image", Anchor: "value-image"}}},
+		{Name: "Other Values", Anchor: "other-values", Keys: []valuesTocKey{
+			{Key: "autoscaling", Anchor: "value-autoscaling.enabled"},
+			{Key: "replicas", Anchor: "value-replicas"},
+		}},
+	}, data.ValuesToc)
+
+	assert.Equal(t, []valuesTocSection{{Keys: []valuesTocKey{
+		{Key: "autoscaling", Anchor: "value-autoscaling.enabled"},
+		{Key: "image", Anchor: "value-image"},
+		{Key: "replicas", Anchor: "value-replicas"},
+	}}}, getValuesToc(data.Values, getSectionedValueRows(nil)))
+	assert.Nil(t, getValuesToc(nil, getSectionedValueRows(nil)))
+}
+
+func TestGetValuesTocSharedKeys(t *testing.T) {
+	serviceRows := []valueRow{
+		{Key: "controller.extraVolumes[0].configMap.name", Anchor: "value-controller.extraVolumes-0-.configMap.name"},
+		{Key: "controller.service.type", Anchor: "value-controller.service.type"},
+	}
+	otherRows := []valueRow{
+		{Key: "controller.extraVolumes[0].name", Anchor: "value-controller.extraVolumes-0-.name"},
+		{Key: "controller.image.tag", Anchor: "value-controller.image.tag"},
+		{Key: "ingress.enabled", Anchor: "value-ingress.enabled"},
+	}
+
+	sectionedValueRows := sections{
+		Sections:       []section{{SectionName: "Service", Anchor: "service", SectionItems: serviceRows}},
+		DefaultSection: section{SectionName: "Other Values", Anchor: "other-values", SectionItems: otherRows},
+	}
+
+	// Keys with values in both sections are listed in each by the keys nested in them belonging to the section alone
+	assert.Equal(t, []valuesTocSection{
+		{Name: "Service", Anchor: "service", Keys: []valuesTocKey{
+			{Key: "controller.extraVolumes[0].configMap", Anchor: "value-controller.extraVolumes-0-.configMap.name"},
+			{Key: "controller.service", Anchor: "value-controller.service.type"},
+		}},
+		{Name: "Other Values", Anchor: "other-values", Keys: []valuesTocKey{
+			{Key: "controller.extraVolumes[0].name", Anchor: "value-controller.extraVolumes-0-.name"},
+			{Key: "controller.image", Anchor: "value-controller.image.tag"},
+			{Key: "ingress", Anchor: "value-ingress.enabled"},
+		}},
+	}, getValuesToc(append(serviceRows, otherRows...), sectionedValueRows))
+}
+
+func TestValueAnchorsInMarkdownAndHtmlTables(t *testing.T) {
+	data := getReferencesTestTemplateData(t, true)
+	linkValueReferences(&data, MarkdownOutputFormat)
+
+	templateFile := filepath.Join(data.ChartDirectory, "README.md.gotmpl")
+	require.NoError(t, os.WriteFile(templateFile, []byte(`{{ template "chart.valuesTable" . }}{{ template "chart.valuesTableHtml" . }}`), 0644))
+
+	tpl, err := newChartDocumentationTemplate(data.ChartDocumentationInfo, data.ChartDirectory, []string{"README.md.gotmpl"}, "flat-square", &translator{}, MarkdownOutputFormat)
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	require.NoError(t, tpl.Execute(&output, data))
+
+	assert.Equal(t, 1, strings.Count(output.String(), `id="value-replicas"`))
+	assert.Contains(t, output.String(), "| <a id=\"value-replicas\"></a>replicas | int | `1` | Number of replicas (see [autoscaling.enabled](#value-autoscaling.enabled), [redis.replicas](../redis/README.md#value-replicas)) |")
+	assert.Contains(t, output.String(), `<td>Number of replicas (see <a href="#value-autoscaling.enabled">autoscaling.enabled</a>, <a href="../redis/README.md#value-replicas">redis.replicas</a>)</td>`)
+	assert.Contains(t, output.String(), `<td>Replaces <a href="#value-replicas">replicas</a>, see also <code>unknown.key</code> and <code>postgresql.auth.password</code></td>`)
+}
@@ -0,0 +1,754 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const defaultAsciiDocDocumentationTemplate = `{{ template "chart.header" . }}
+{{ template "chart.deprecationWarning" . }}
+
+{{ template "chart.badgesSection" . }}
+
+{{ template "chart.description" . }}
+
+{{ template "chart.homepageLine" . }}
+
+{{ template "chart.maintainersSection" . }}
+
+{{ template "chart.sourcesSection" . }}
+
+{{ template "chart.requirementsSection" . }}
+
+{{ template "chart.valuesSection" . }}
+
+{{- if not .SkipVersionFooter }}
+{{ template "helm-docs.versionFooter" . }}
+{{- end }}
+`
+
+var asciiDocAttributeReferenceRegex = regexp.MustCompile(`\{[A-Za-z0-9_][A-Za-z0-9_-]*\}`)
+var asciiDocDelimitedBlockRegex = regexp.MustCompile(`^(-{4,}|\.{4,}|\+{4,}|/{4,})$`)
+
+// getDocumentFormat returns the format documentation is rendered in to the given output file. Output files with an
+// AsciiDoc extension are always rendered as AsciiDoc, others in the configured output format.
+func getDocumentFormat(outputFile string) string {
+	switch strings.ToLower(filepath.Ext(outputFile)) {
+	case ".adoc", ".asciidoc":
+		return AsciiDocOutputFormat
+	}
+
+	if GetOutputFormat() == AsciiDocOutputFormat {
+		return AsciiDocOutputFormat
+	}
+
+	return MarkdownOutputFormat
+}
+
+// escapeTableCell returns a function escaping text rendered into a table cell of the given format. Cell separators are
+// escaped in both formats, AsciiDoc additionally escapes attribute references like {name}, which would otherwise be
+// replaced by the value of the attribute.
+func escapeTableCell(format string) func(string) string {
+	return func(text string) string {
+		text = strings.ReplaceAll(text, "|", "\\|")
+		if format == AsciiDocOutputFormat {
+			text = asciiDocAttributeReferenceRegex.ReplaceAllString(text, "\\$0")
+		}
+
+		return text
+	}
+}
+
+func getAsciiDocHeaderTemplate() string {
+	headerTemplateBuilder := strings.Builder{}
+	headerTemplateBuilder.WriteString(`{{ define "chart.header" }}`)
+	headerTemplateBuilder.WriteString("= {{ .Name }}\n")
+	headerTemplateBuilder.WriteString("{{ end }}")
+
+	return headerTemplateBuilder.String()
+}
+
+func getAsciiDocIconTemplates() string {
+	iconBuilder := strings.Builder{}
+	iconBuilder.WriteString(`{{ define "chart.icon" }}{{ .Icon }}{{ end }}`)
+	iconBuilder.WriteString(`{{ define "chart.iconImage" }}`)
+	iconBuilder.WriteString("{{ if .Icon }}image:{{ .Icon }}[{{ .Name }},height=64]{{ end }}")
+	iconBuilder.WriteString("{{ end }}")
+
+	iconBuilder.WriteString(`{{ define "chart.iconHeader" }}`)
+	iconBuilder.WriteString("{{ if .Icon }}= image:{{ .Icon }}[height=32] {{ .Name }}\n{{ else }}{{ template \"chart.header\" . }}{{ end }}")
+	iconBuilder.WriteString("{{ end }}")
+
+	return iconBuilder.String()
+}
+
+func getAsciiDocDeprecatedTemplate() string {
+	deprecatedTemplateBuilder := strings.Builder{}
+	deprecatedTemplateBuilder.WriteString(`{{ define "chart.deprecationWarning" }}`)
+	deprecatedTemplateBuilder.WriteString("{{ if .Deprecated }}WARNING: This Helm Chart is deprecated!{{ end }}")
+	deprecatedTemplateBuilder.WriteString("{{ end }}")
+
+	return deprecatedTemplateBuilder.String()
+}
+
+func getAsciiDocBadgeTemplates(badgeStyle string) string {
+	badgeBuilder := strings.Builder{}
+	badgeBuilder.WriteString(`{{ define "chart.version" }}{{ .Version }}{{ end }}`)
+	badgeBuilder.WriteString(`{{ define "chart.versionBadge" }}`)
+	badgeBuilder.WriteString(fmt.Sprintf(`image:https://img.shields.io/badge/Version-{{ .Version | replace "-" "--" }}-informational?style=%s[Version: {{ .Version }}] `, badgeStyle))
+	badgeBuilder.WriteString("{{ end }}")
+
+	badgeBuilder.WriteString(`{{ define "chart.type" }}{{ .Type }}{{ end }}`)
+	badgeBuilder.WriteString(`{{ define "chart.typeBadge" }}`)
+	badgeBuilder.WriteString(fmt.Sprintf("{{ if .Type }}image:https://img.shields.io/badge/Type-{{ .Type }}-informational?style=%s[Type: {{ .Type }}] {{ end }}", badgeStyle))
+	badgeBuilder.WriteString("{{ end }}")
+
+	badgeBuilder.WriteString(`{{ define "chart.appVersion" }}{{ .AppVersion }}{{ end }}`)
+	badgeBuilder.WriteString(`{{ define "chart.appVersionBadge" }}`)
+	badgeBuilder.WriteString(fmt.Sprintf(`{{ if .AppVersion }}image:https://img.shields.io/badge/AppVersion-{{ .AppVersion | replace "-" "--" }}-informational?style=%s[AppVersion: {{ .AppVersion }}] {{ end }}`, badgeStyle))
+	badgeBuilder.WriteString("{{ end }}")
+
+	badgeBuilder.WriteString(`{{ define "chart.badgesSection" }}`)
+	badgeBuilder.WriteString(`{{ template "chart.versionBadge" . }}{{ template "chart.typeBadge" . }}{{ template "chart.appVersionBadge" . }}`)
+	badgeBuilder.WriteString("{{ end }}")
+
+	return badgeBuilder.String()
+}
+
+func getAsciiDocHomepageTemplate() string {
+	homepageBuilder := strings.Builder{}
+	homepageBuilder.WriteString(`{{ define "chart.homepage" }}{{ .Home }}{{ end }}`)
+	homepageBuilder.WriteString(`{{ define "chart.homepageLine" }}`)
+	homepageBuilder.WriteString("{{ if .Home }}*Homepage:* {{ .Home }}{{ end }}")
+	homepageBuilder.WriteString("{{ end }}")
+
+	return homepageBuilder.String()
+}
+
+func getAsciiDocKeywordsTemplates() string {
+	keywordsBuilder := strings.Builder{}
+	keywordsBuilder.WriteString(`{{ define "chart.keywords" }}{{ join ", " .Keywords }}{{ end }}`)
+	keywordsBuilder.WriteString(`{{ define "chart.keywordsLine" }}`)
+	keywordsBuilder.WriteString("{{ if .Keywords }}*Keywords:*{{ range .Keywords }} `+{{ . }}+`{{ end }}{{ end }}")
+	keywordsBuilder.WriteString("{{ end }}")
+
+	return keywordsBuilder.String()
+}
+
+func getAsciiDocMaintainersTemplate() string {
+	maintainerBuilder := strings.Builder{}
+	maintainerBuilder.WriteString(`{{ define "chart.maintainersHeader" }}== {{ translate "Maintainers" }}{{ end }}`)
+
+	maintainerBuilder.WriteString(`{{ define "chart.maintainersTable" }}`)
+	maintainerBuilder.WriteString("[options=\"header\"]\n")
+	maintainerBuilder.WriteString("|===\n")
+	maintainerBuilder.WriteString("| Name | Email | URL")
+	maintainerBuilder.WriteString("  {{- range .Maintainers }}")
+	maintainerBuilder.WriteString("\n| {{ .Name | escapeCell }} | {{ if .Email }}mailto:{{ .Email }}[]{{ end }} | {{ if .Url }}{{ .Url }}{{ end }}")
+	maintainerBuilder.WriteString("  {{- end }}")
+	maintainerBuilder.WriteString("\n|===")
+	maintainerBuilder.WriteString("{{ end }}")
+
+	maintainerBuilder.WriteString(`{{ define "chart.maintainersSection" }}`)
+	maintainerBuilder.WriteString("{{ if .Maintainers }}")
+	maintainerBuilder.WriteString(`{{ template "chart.maintainersHeader" . }}`)
+	maintainerBuilder.WriteString("\n\n")
+	maintainerBuilder.WriteString(`{{ template "chart.maintainersTable" . }}`)
+	maintainerBuilder.WriteString("{{ end }}")
+	maintainerBuilder.WriteString("{{ end }}")
+
+	return maintainerBuilder.String()
+}
+
+func getAsciiDocSourceLinkTemplates() string {
+	sourceLinkBuilder := strings.Builder{}
+	sourceLinkBuilder.WriteString(`{{ define "chart.sourcesHeader" }}== {{ translate "Source Code" }}{{ end }}`)
+
+	sourceLinkBuilder.WriteString(`{{ define "chart.sourcesList" }}`)
+	sourceLinkBuilder.WriteString("{{- range .Sources }}")
+	sourceLinkBuilder.WriteString("\n* {{ . }}")
+	sourceLinkBuilder.WriteString("{{- end }}")
+	sourceLinkBuilder.WriteString("{{ end }}")
+
+	sourceLinkBuilder.WriteString(`{{ define "chart.sourcesSection" }}`)
+	sourceLinkBuilder.WriteString("{{ if .Sources }}")
+	sourceLinkBuilder.WriteString(`{{ template "chart.sourcesHeader" . }}`)
+	sourceLinkBuilder.WriteString("\n")
+	sourceLinkBuilder.WriteString(`{{ template "chart.sourcesList" . }}`)
+	sourceLinkBuilder.WriteString("{{ end }}")
+	sourceLinkBuilder.WriteString("{{ end }}")
+
+	return sourceLinkBuilder.String()
+}
+
+func getAsciiDocArtifactHubTemplates() string {
+	artifactHubBuilder := strings.Builder{}
+	artifactHubBuilder.WriteString(`{{ define "chart.changelogHeader" }}== {{ translate "Changelog" }}{{ end }}`)
+
+	artifactHubBuilder.WriteString(`{{ define "chart.changelogList" }}`)
+	artifactHubBuilder.WriteString("{{- range .ArtifactHub.Changes }}")
+	artifactHubBuilder.WriteString("\n* {{ if .Kind }}*{{ .Kind | title }}:* {{ end }}{{ .Description }}{{ range .Links }} ({{ .URL }}[{{ .Name }}]){{ end }}")
+	artifactHubBuilder.WriteString("{{- end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.changelogSection" }}`)
+	artifactHubBuilder.WriteString("{{ if .ArtifactHub.Changes }}")
+	artifactHubBuilder.WriteString(`{{ template "chart.changelogHeader" . }}`)
+	artifactHubBuilder.WriteString("\n\n=== {{ .Version }}\n")
+	artifactHubBuilder.WriteString(`{{ template "chart.changelogList" . }}`)
+	artifactHubBuilder.WriteString("{{ end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.imagesHeader" }}== {{ translate "Images" }}{{ end }}`)
+
+	artifactHubBuilder.WriteString(`{{ define "chart.imagesTable" }}`)
+	artifactHubBuilder.WriteString("[options=\"header\"]\n")
+	artifactHubBuilder.WriteString("|===\n")
+	artifactHubBuilder.WriteString("| Name | Image | Platforms")
+	artifactHubBuilder.WriteString("  {{- range .ArtifactHub.Images }}")
+	artifactHubBuilder.WriteString("\n| {{ .Name | escapeCell }} | `+{{ .Image }}+` | {{ join \", \" .Platforms }}")
+	artifactHubBuilder.WriteString("  {{- end }}")
+	artifactHubBuilder.WriteString("\n|===")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.imagesSection" }}`)
+	artifactHubBuilder.WriteString("{{ if .ArtifactHub.Images }}")
+	artifactHubBuilder.WriteString(`{{ template "chart.imagesHeader" . }}`)
+	artifactHubBuilder.WriteString("\n\n")
+	artifactHubBuilder.WriteString(`{{ template "chart.imagesTable" . }}`)
+	artifactHubBuilder.WriteString("{{ end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.linksHeader" }}== {{ translate "Links" }}{{ end }}`)
+
+	artifactHubBuilder.WriteString(`{{ define "chart.linksList" }}`)
+	artifactHubBuilder.WriteString("{{- range .ArtifactHub.Links }}")
+	artifactHubBuilder.WriteString("\n* {{ .URL }}[{{ .Name }}]")
+	artifactHubBuilder.WriteString("{{- end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.linksSection" }}`)
+	artifactHubBuilder.WriteString("{{ if .ArtifactHub.Links }}")
+	artifactHubBuilder.WriteString(`{{ template "chart.linksHeader" . }}`)
+	artifactHubBuilder.WriteString("\n")
+	artifactHubBuilder.WriteString(`{{ template "chart.linksList" . }}`)
+	artifactHubBuilder.WriteString("{{ end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	return artifactHubBuilder.String()
+}
+
+func getAsciiDocCustomResourceDefinitionsTemplates() string {
+	crdsBuilder := strings.Builder{}
+	crdsBuilder.WriteString(`{{ define "chart.crdsHeader" }}== {{ translate "Custom Resource Definitions" }}{{ end }}`)
+
+	crdsBuilder.WriteString(`{{ define "chart.crdVersionTable" }}`)
+	crdsBuilder.WriteString("[cols=\"3,1,1,4\",options=\"header\"]\n")
+	crdsBuilder.WriteString("|===\n")
+	crdsBuilder.WriteString("| Field | Type | Required | Description")
+	crdsBuilder.WriteString("  {{- range .Properties }}")
+	crdsBuilder.WriteString("\n| `+{{ .Path }}+` | {{ .Type | escapeCell }} | {{ .Required }} | {{ .Description | escapeCell }}")
+	crdsBuilder.WriteString("  {{- end }}")
+	crdsBuilder.WriteString("\n|===")
+	crdsBuilder.WriteString("{{ end }}")
+
+	crdsBuilder.WriteString(`{{ define "chart.crdsTables" }}`)
+	crdsBuilder.WriteString("{{- range $crd := .CustomResourceDefinitions }}")
+	crdsBuilder.WriteString("\n\n=== {{ .Kind }}\n\n`+{{ .Name }}+`, {{ .Scope }}{{ with .File }}, defined in link:{{ . }}[]{{ end }}")
+	crdsBuilder.WriteString("{{- range .Versions }}")
+	crdsBuilder.WriteString("\n\n==== {{ $crd.Group }}/{{ .Name }}")
+	crdsBuilder.WriteString("{{- if .Deprecated }}\n\nWARNING: {{ translate \"Deprecated\" }}{{ with .DeprecationWarning }}: {{ . }}{{ end }}{{ end }}")
+	crdsBuilder.WriteString("{{- if .Properties }}\n\n{{ template \"chart.crdVersionTable\" . }}{{ end }}")
+	crdsBuilder.WriteString("{{- end }}")
+	crdsBuilder.WriteString("{{- range .Examples }}\n\n==== {{ translate \"Example\" }}\n\n[source,yaml]\n----\n{{ .Yaml }}----{{ end }}")
+	crdsBuilder.WriteString("{{- end }}")
+	crdsBuilder.WriteString("{{ end }}")
+
+	crdsBuilder.WriteString(`{{ define "chart.crdsSection" }}`)
+	crdsBuilder.WriteString("{{ if .CustomResourceDefinitions }}")
+	crdsBuilder.WriteString(`{{ template "chart.crdsHeader" . }}`)
+	crdsBuilder.WriteString(`{{ template "chart.crdsTables" . }}`)
+	crdsBuilder.WriteString("{{ end }}")
+	crdsBuilder.WriteString("{{ end }}")
+
+	return crdsBuilder.String()
+}
+
+func getAsciiDocNamedTemplatesTemplates() string {
+	namedTemplatesBuilder := strings.Builder{}
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplatesHeader" }}== {{ translate "Named Templates" }}{{ end }}`)
+
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplateParametersTable" }}`)
+	namedTemplatesBuilder.WriteString("[cols=\"1,1,4\",options=\"header\"]\n")
+	namedTemplatesBuilder.WriteString("|===\n")
+	namedTemplatesBuilder.WriteString("| Parameter | Type | Description")
+	namedTemplatesBuilder.WriteString("  {{- range .Parameters }}")
+	namedTemplatesBuilder.WriteString("\n| `+{{ .Name }}+` | {{ .Type | escapeCell }} | {{ .Description | escapeCell }}")
+	namedTemplatesBuilder.WriteString("  {{- end }}")
+	namedTemplatesBuilder.WriteString("\n|===")
+	namedTemplatesBuilder.WriteString("{{ end }}")
+
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplatesList" }}`)
+	namedTemplatesBuilder.WriteString("{{- range .NamedTemplates }}")
+	namedTemplatesBuilder.WriteString("\n\n=== `+{{ .Name }}+`")
+	namedTemplatesBuilder.WriteString("{{- with .Description }}\n\n{{ . }}{{ end }}")
+	namedTemplatesBuilder.WriteString("\n\n{{ translate \"Defined in\" }} link:{{ .File }}[]")
+	namedTemplatesBuilder.WriteString("{{- if .Parameters }}\n\n{{ template \"chart.namedTemplateParametersTable\" . }}{{ end }}")
+	namedTemplatesBuilder.WriteString("{{- with .Example }}\n\n----\n{{ . }}\n----{{ end }}")
+	namedTemplatesBuilder.WriteString("{{- end }}")
+	namedTemplatesBuilder.WriteString("{{ end }}")
+
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplatesSection" }}`)
+	namedTemplatesBuilder.WriteString("{{ if .NamedTemplates }}")
+	namedTemplatesBuilder.WriteString(`{{ template "chart.namedTemplatesHeader" . }}`)
+	namedTemplatesBuilder.WriteString(`{{ template "chart.namedTemplatesList" . }}`)
+	namedTemplatesBuilder.WriteString("{{ end }}")
+	namedTemplatesBuilder.WriteString("{{ end }}")
+
+	return namedTemplatesBuilder.String()
+}
+
+func getAsciiDocRequiredValuesTemplates() string {
+	requiredValuesBuilder := strings.Builder{}
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesHeader" }}== {{ translate "Required Values" }}{{ end }}`)
+
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesTable" }}`)
+	requiredValuesBuilder.WriteString("[cols=\"1,2,2\",options=\"header\"]\n")
+	requiredValuesBuilder.WriteString("|===\n")
+	requiredValuesBuilder.WriteString("| Key | Message | Description")
+	requiredValuesBuilder.WriteString("  {{- range .RequiredValues }}")
+	requiredValuesBuilder.WriteString("\n| {{ if .Anchor }}<<{{ .Anchor }},`+{{ .Key }}+`>>{{ else }}`+{{ .Key }}+`{{ end }} | {{ .Message | escapeCell }} | {{ .Description | escapeCell }}")
+	requiredValuesBuilder.WriteString("  {{- end }}")
+	requiredValuesBuilder.WriteString("\n|===")
+	requiredValuesBuilder.WriteString("{{ end }}")
+
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesInstall" }}`)
+	requiredValuesBuilder.WriteString("[source,console]\n----\n")
+	requiredValuesBuilder.WriteString("$ helm install my-release {{ .Name }}")
+	requiredValuesBuilder.WriteString("{{ range .RequiredValues }} \\\n    --set {{ .SetKey }}=<value>{{ end }}")
+	requiredValuesBuilder.WriteString("\n----")
+	requiredValuesBuilder.WriteString("{{ end }}")
+
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesSection" }}`)
+	requiredValuesBuilder.WriteString("{{ if .RequiredValues }}")
+	requiredValuesBuilder.WriteString(`{{ template "chart.requiredValuesHeader" . }}`)
+	requiredValuesBuilder.WriteString("\n\n")
+	requiredValuesBuilder.WriteString(`{{ template "chart.requiredValuesTable" . }}`)
+	requiredValuesBuilder.WriteString("\n\n")
+	requiredValuesBuilder.WriteString(`{{ template "chart.requiredValuesInstall" . }}`)
+	requiredValuesBuilder.WriteString("{{ end }}")
+	requiredValuesBuilder.WriteString("{{ end }}")
+
+	return requiredValuesBuilder.String()
+}
+
+func getAsciiDocRenderedResourcesTemplates() string {
+	resourcesBuilder := strings.Builder{}
+	resourcesBuilder.WriteString(`{{ define "chart.resourcesHeader" }}== {{ translate "Resources Created" }}{{ end }}`)
+
+	resourcesBuilder.WriteString(`{{ define "chart.resourcesTable" }}`)
+	resourcesBuilder.WriteString("[cols=\"1,1,2,1,2\",options=\"header\"]\n")
+	resourcesBuilder.WriteString("|===\n")
+	resourcesBuilder.WriteString("| Kind | API Version | Name | Scope | Template")
+	resourcesBuilder.WriteString("  {{- range .RenderedResources }}")
+	resourcesBuilder.WriteString("\n| {{ .Kind }}{{ with .Hook }} ({{ translate \"hook\" }} {{ . }}){{ end }} | {{ .APIVersion }} | `+{{ .Name }}+` | ")
+	resourcesBuilder.WriteString("{{ if .Namespaced }}Namespaced{{ with .Namespace }} (`+{{ . }}+`){{ end }}{{ else }}Cluster{{ end }} | link:{{ .File }}[]")
+	resourcesBuilder.WriteString("  {{- end }}")
+	resourcesBuilder.WriteString("\n|===")
+	resourcesBuilder.WriteString("{{ end }}")
+
+	resourcesBuilder.WriteString(`{{ define "chart.resourcesSection" }}`)
+	resourcesBuilder.WriteString("{{ if .RenderedResources }}")
+	resourcesBuilder.WriteString(`{{ template "chart.resourcesHeader" . }}`)
+	resourcesBuilder.WriteString("\n\n")
+	resourcesBuilder.WriteString(`{{ template "chart.resourcesTable" . }}`)
+	resourcesBuilder.WriteString("{{ end }}")
+	resourcesBuilder.WriteString("{{ end }}")
+
+	return resourcesBuilder.String()
+}
+
+func getAsciiDocRBACTemplates() string {
+	rbacBuilder := strings.Builder{}
+	rbacBuilder.WriteString(`{{ define "chart.rbacHeader" }}== {{ translate "RBAC Permissions" }}{{ end }}`)
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacTogglesRenderAdoc" }}`)
+	rbacBuilder.WriteString(`{{ with .Toggles }} ({{ translate "toggled by" }} {{ range $i, $key := . }}{{ if $i }}, {{ end }}` + "`+{{ $key }}+`" + `{{ end }}){{ end }}`)
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacRuleRenderAdoc" }}`)
+	rbacBuilder.WriteString(" {{ range $i, $group := .APIGroups }}{{ if $i }}, {{ end }}{{ if $group }}`+{{ $group }}+`{{ else }}core{{ end }}{{ end }}")
+	rbacBuilder.WriteString(" | {{ range $i, $resource := .Resources }}{{ if $i }}, {{ end }}`+{{ $resource }}+`{{ end }}")
+	rbacBuilder.WriteString("{{ with .ResourceNames }} ({{ range $i, $name := . }}{{ if $i }}, {{ end }}`+{{ $name }}+`{{ end }}){{ end }}")
+	rbacBuilder.WriteString("{{ range $i, $url := .NonResourceURLs }}{{ if or $i $.Resources }}, {{ end }}`+{{ $url }}+`{{ end }}")
+	rbacBuilder.WriteString(" | {{ range $i, $verb := .Verbs }}{{ if $i }}, {{ end }}`+{{ $verb }}+`{{ end }}")
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacRulesTable" }}`)
+	rbacBuilder.WriteString("[cols=\"2,1,2,2\",options=\"header\"]\n")
+	rbacBuilder.WriteString("|===\n")
+	rbacBuilder.WriteString("| Role | API Groups | Resources | Verbs")
+	rbacBuilder.WriteString("  {{- range $role := .RBAC.Roles }}")
+	rbacBuilder.WriteString("    {{- range .Rules }}")
+	rbacBuilder.WriteString("\n| {{ $role.Kind }} `+{{ $role.Name }}+`{{ template \"chart.rbacTogglesRenderAdoc\" $role }} |{{ template \"chart.rbacRuleRenderAdoc\" . }}")
+	rbacBuilder.WriteString("    {{- else }}")
+	rbacBuilder.WriteString("\n| {{ $role.Kind }} `+{{ $role.Name }}+`{{ template \"chart.rbacTogglesRenderAdoc\" $role }} |  |  |")
+	rbacBuilder.WriteString("    {{- end }}")
+	rbacBuilder.WriteString("  {{- end }}")
+	rbacBuilder.WriteString("\n|===")
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacBindingsTable" }}`)
+	rbacBuilder.WriteString("[cols=\"2,2,3\",options=\"header\"]\n")
+	rbacBuilder.WriteString("|===\n")
+	rbacBuilder.WriteString("| Binding | Role | Subjects")
+	rbacBuilder.WriteString("  {{- range .RBAC.Bindings }}")
+	rbacBuilder.WriteString("\n| {{ .Kind }} `+{{ .Name }}+`{{ template \"chart.rbacTogglesRenderAdoc\" . }} | {{ .RoleRef.Kind }} `+{{ .RoleRef.Name }}+`")
+	rbacBuilder.WriteString(" | {{ range $i, $subject := .Subjects }}{{ if $i }}, {{ end }}{{ .Kind }} `+{{ .Name }}+`{{ with .Namespace }} ({{ translate \"in\" }} `+{{ . }}+`){{ end }}{{ end }}")
+	rbacBuilder.WriteString("  {{- end }}")
+	rbacBuilder.WriteString("\n|===")
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacSection" }}`)
+	rbacBuilder.WriteString("{{ if or .RBAC.Roles .RBAC.Bindings }}")
+	rbacBuilder.WriteString(`{{ template "chart.rbacHeader" . }}`)
+	rbacBuilder.WriteString("{{ if .RBAC.Roles }}\n\n{{ template \"chart.rbacRulesTable\" . }}{{ end }}")
+	rbacBuilder.WriteString("{{ if .RBAC.Bindings }}\n\n{{ template \"chart.rbacBindingsTable\" . }}{{ end }}")
+	rbacBuilder.WriteString("{{ end }}")
+	rbacBuilder.WriteString("{{ end }}")
+
+	return rbacBuilder.String()
+}
+
+func getAsciiDocKubeCompatibilityTemplates() string {
+	kubeCompatibilityBuilder := strings.Builder{}
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeCompatibilityHeader" }}== {{ translate "Kubernetes Compatibility" }}{{ end }}`)
+
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeAPIChangesRenderAdoc" }}`)
+	kubeCompatibilityBuilder.WriteString("{{ range $i, $api := . }}{{ if $i }}, {{ end }}`+{{ .APIVersion }}+` {{ .Kind }}")
+	kubeCompatibilityBuilder.WriteString(`{{ with .Replacement }} ({{ translate "use" }} ` + "`+{{ . }}+`" + `){{ end }}{{ end }}`)
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeCompatibilityTable" }}`)
+	kubeCompatibilityBuilder.WriteString("[cols=\"1,1,3,3\",options=\"header\"]\n")
+	kubeCompatibilityBuilder.WriteString("|===\n")
+	kubeCompatibilityBuilder.WriteString("| Kubernetes | Supported | Removed APIs | Deprecated APIs")
+	kubeCompatibilityBuilder.WriteString("  {{- range .KubernetesCompatibility }}")
+	kubeCompatibilityBuilder.WriteString("\n| {{ .Version }} | {{ if .Partial }}{{ translate \"Partially\" }}{{ else if .Supported }}{{ translate \"Yes\" }}{{ else }}{{ translate \"No\" }}{{ end }} |")
+	kubeCompatibilityBuilder.WriteString(` {{ template "chart.kubeAPIChangesRenderAdoc" .RemovedAPIs }} | {{ template "chart.kubeAPIChangesRenderAdoc" .DeprecatedAPIs }}`)
+	kubeCompatibilityBuilder.WriteString("  {{- end }}")
+	kubeCompatibilityBuilder.WriteString("\n|===")
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeCompatibilitySection" }}`)
+	kubeCompatibilityBuilder.WriteString("{{ if .KubernetesCompatibility }}")
+	kubeCompatibilityBuilder.WriteString(`{{ template "chart.kubeCompatibilityHeader" . }}`)
+	kubeCompatibilityBuilder.WriteString("\n\n")
+	kubeCompatibilityBuilder.WriteString(`{{ template "chart.kubeVersionLine" . }}`)
+	kubeCompatibilityBuilder.WriteString("\n\n")
+	kubeCompatibilityBuilder.WriteString(`{{ template "chart.kubeCompatibilityTable" . }}`)
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+
+	return kubeCompatibilityBuilder.String()
+}
+
+func getAsciiDocRequirementsTableTemplates() string {
+	requirementsSectionBuilder := strings.Builder{}
+	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsHeader" }}== {{ translate "Requirements" }}{{ end }}`)
+
+	requirementsSectionBuilder.WriteString(`{{ define "chart.kubeVersion" }}{{ .KubeVersion }}{{ end }}`)
+	requirementsSectionBuilder.WriteString(`{{ define "chart.kubeVersionLine" }}`)
+	requirementsSectionBuilder.WriteString("{{ if .KubeVersion }}Kubernetes: `+{{ .KubeVersion }}+`{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+
+	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsTable" }}`)
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasControls }}")
+	requirementsSectionBuilder.WriteString(`{{ template "chart.requirementsControlsTable" . }}`)
+	requirementsSectionBuilder.WriteString("{{ else }}")
+	requirementsSectionBuilder.WriteString("[options=\"header\"]\n")
+	requirementsSectionBuilder.WriteString("|===\n")
+	requirementsSectionBuilder.WriteString("| Repository | Name | Version{{ if .Requirements.Locked }} | Locked Version{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- range .Requirements.Dependencies }}")
+	requirementsSectionBuilder.WriteString("    {{- if .Alias }}")
+	requirementsSectionBuilder.WriteString("\n| {{ .Repository | escapeCell }} | {{ .Alias }}({{ .Name }}) | {{ .Version | escapeCell }}")
+	requirementsSectionBuilder.WriteString("    {{- else }}")
+	requirementsSectionBuilder.WriteString("\n| {{ .Repository | escapeCell }} | {{ .Name }} | {{ .Version | escapeCell }}")
+	requirementsSectionBuilder.WriteString("    {{- end }}")
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.Locked }} | {{ .LockedVersion | escapeCell }}{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- end }}")
+	requirementsSectionBuilder.WriteString("\n|===")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+
+	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsControlsTable" }}`)
+	requirementsSectionBuilder.WriteString("[options=\"header\"]\n")
+	requirementsSectionBuilder.WriteString("|===\n")
+	requirementsSectionBuilder.WriteString("| Repository | Name | Version{{ if .Requirements.Locked }} | Locked Version{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasToggles }} | Enabled{{ end }}{{ if .Requirements.HasConditions }} | Condition{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasTags }} | Tags{{ end }}{{ if .Requirements.HasImports }} | Import Values{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- range .Requirements.Dependencies }}")
+	requirementsSectionBuilder.WriteString("\n| {{ .Repository | escapeCell }} | {{ if .Alias }}{{ .Alias }}({{ .Name }}){{ else }}{{ .Name }}{{ end }} | {{ .Version | escapeCell }}")
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.Locked }} | {{ .LockedVersion | escapeCell }}{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.HasToggles }} | {{ .EnabledByDefault }}{{ end }}")
+	requirementsSectionBuilder.WriteString(`{{ if $.Requirements.HasConditions }} | {{ range $i, $reference := .Conditions }}{{ if $i }}, {{ end }}{{ template "chart.valueReferenceRenderAdoc" $reference }}{{ end }}{{ end }}`)
+	requirementsSectionBuilder.WriteString(`{{ if $.Requirements.HasTags }} | {{ range $i, $reference := .TagValues }}{{ if $i }}, {{ end }}{{ template "chart.valueReferenceRenderAdoc" $reference }}{{ end }}{{ end }}`)
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.HasImports }} | {{ range $i, $import := .Imports }}{{ if $i }}, {{ end }}`+{{ $import.Child }}+` → `+{{ default \".\" $import.Parent }}+`{{ end }}{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- end }}")
+	requirementsSectionBuilder.WriteString("\n|===")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+
+	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsSection" }}`)
+	requirementsSectionBuilder.WriteString("{{ if or .Dependencies .KubeVersion }}")
+	requirementsSectionBuilder.WriteString(`{{ template "chart.requirementsHeader" . }}`)
+	requirementsSectionBuilder.WriteString("\n\n")
+	requirementsSectionBuilder.WriteString("{{ if .KubeVersion }}")
+	requirementsSectionBuilder.WriteString(`{{ template "chart.kubeVersionLine" . }}`)
+	requirementsSectionBuilder.WriteString("\n\n")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Dependencies }}")
+	requirementsSectionBuilder.WriteString(`{{ template "chart.requirementsTable" . }}`)
+	requirementsSectionBuilder.WriteString("{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+
+	return requirementsSectionBuilder.String()
+}
+
+func getAsciiDocValuesTableTemplates() string {
+	valuesSectionBuilder := strings.Builder{}
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesHeader" }}== {{ translate "Values" }}{{ end }}`)
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueKeyColumnRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString("{{ valueAnchor .Anchor }}{{ .Key | escapeCell }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueTypeColumnRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString("{{ .Type | escapeCell }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	// Default values are rendered in markdown code spans, which are turned into literal monospace text
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueDefaultColumnRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString("{{ $defaultValue := (default .AutoDefault .Default) }}")
+	valuesSectionBuilder.WriteString("{{ if and (hasPrefix \"`\" $defaultValue) (hasSuffix \"`\" $defaultValue) }}")
+	valuesSectionBuilder.WriteString("`+{{ trimAll \"`\" $defaultValue | replace \"|\" \"\\\\|\" }}+`")
+	valuesSectionBuilder.WriteString("{{ else }}{{ $defaultValue | escapeCell }}{{ end }}")
+	valuesSectionBuilder.WriteString(`{{ with .DefaultFrom }} ({{ translate "from" }} {{ . | escapeCell }}){{ end }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueReferenceRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString("{{ if not .Anchor }}`+{{ .Key }}+`")
+	valuesSectionBuilder.WriteString("{{ else if .Path }}link:{{ .Path }}#{{ .Anchor }}[{{ .Key }}]")
+	valuesSectionBuilder.WriteString("{{ else }}<<{{ .Anchor }},{{ .Key }}>>{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueSeeAlsoRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString(`{{ with .See }} ({{ translate "see" }} {{ range $i, $reference := . }}{{ if $i }}, {{ end }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueReferenceRenderAdoc" $reference }}`)
+	valuesSectionBuilder.WriteString("{{ end }}){{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueRequiredRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString(`{{ if .Required }}*{{ translate "Required" }}*{{ with .RequiredMessage }} ({{ . | escapeCell }}){{ end }}`)
+	valuesSectionBuilder.WriteString("{{ if or .Description .AutoDescription }} {{ end }}{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueDescriptionColumnRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueRequiredRenderAdoc" . }}`)
+	valuesSectionBuilder.WriteString("{{ if .Description }}{{ .Description | escapeCell }}{{ else }}{{ .AutoDescription | escapeCell }}{{ end }}")
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueSeeAlsoRenderAdoc" . }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesTableRowsAdoc" }}`)
+	valuesSectionBuilder.WriteString("[cols=\"2,1,2,4\",options=\"header\"]\n")
+	valuesSectionBuilder.WriteString("|===\n")
+	valuesSectionBuilder.WriteString("| Key | Type | Default | Description")
+	valuesSectionBuilder.WriteString("  {{- range . }}")
+	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderAdoc" . }} | {{ template "chart.valueTypeColumnRenderAdoc" . }} | {{ template "chart.valueDefaultColumnRenderAdoc" . }} | {{ template "chart.valueDescriptionColumnRenderAdoc" . }}`)
+	valuesSectionBuilder.WriteString("  {{- end }}")
+	valuesSectionBuilder.WriteString("\n|===")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueUsedInColumnRenderAdoc" }}`)
+	valuesSectionBuilder.WriteString(`{{ range $i, $file := .UsedIn }}{{ if $i }}, {{ end }}link:{{ $file }}[]{{ end }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesTableRowsUsedInAdoc" }}`)
+	valuesSectionBuilder.WriteString("[cols=\"2,1,2,4,2\",options=\"header\"]\n")
+	valuesSectionBuilder.WriteString("|===\n")
+	valuesSectionBuilder.WriteString("| Key | Type | Default | Description | Used in")
+	valuesSectionBuilder.WriteString("  {{- range . }}")
+	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderAdoc" . }} | {{ template "chart.valueTypeColumnRenderAdoc" . }} | {{ template "chart.valueDefaultColumnRenderAdoc" . }} | {{ template "chart.valueDescriptionColumnRenderAdoc" . }} | {{ template "chart.valueUsedInColumnRenderAdoc" . }}`)
+	valuesSectionBuilder.WriteString("  {{- end }}")
+	valuesSectionBuilder.WriteString("\n|===")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesTable" }}`)
+	valuesSectionBuilder.WriteString("{{ if .Sections.Sections }}")
+	valuesSectionBuilder.WriteString("{{ range .Sections.Sections }}")
+	valuesSectionBuilder.WriteString("\n")
+	valuesSectionBuilder.WriteString("\n[[{{ .Anchor }}]]\n=== {{ .SectionName }}\n")
+	valuesSectionBuilder.WriteString("\n")
+	valuesSectionBuilder.WriteString(`{{ if $.ValuesUsedInColumn }}{{ template "chart.valuesTableRowsUsedInAdoc" .SectionItems }}{{ else }}{{ template "chart.valuesTableRowsAdoc" .SectionItems }}{{ end }}`)
+	valuesSectionBuilder.WriteString("{{- end }}")
+	valuesSectionBuilder.WriteString("{{ if .Sections.DefaultSection.SectionItems }}")
+	valuesSectionBuilder.WriteString("\n")
+	valuesSectionBuilder.WriteString("\n[[{{ .Sections.DefaultSection.Anchor }}]]\n=== {{ .Sections.DefaultSection.SectionName }}\n")
+	valuesSectionBuilder.WriteString("\n")
+	valuesSectionBuilder.WriteString(`{{ if $.ValuesUsedInColumn }}{{ template "chart.valuesTableRowsUsedInAdoc" .Sections.DefaultSection.SectionItems }}{{ else }}{{ template "chart.valuesTableRowsAdoc" .Sections.DefaultSection.SectionItems }}{{ end }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ else }}")
+	valuesSectionBuilder.WriteString(`{{ if $.ValuesUsedInColumn }}{{ template "chart.valuesTableRowsUsedInAdoc" .Values }}{{ else }}{{ template "chart.valuesTableRowsAdoc" .Values }}{{ end }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesToc" }}`)
+	valuesSectionBuilder.WriteString("{{ range .ValuesToc }}")
+	valuesSectionBuilder.WriteString("{{ if .Name }}")
+	valuesSectionBuilder.WriteString("\n* <<{{ .Anchor }},{{ .Name }}>>")
+	valuesSectionBuilder.WriteString("{{ range .Keys }}\n** {{ if .Anchor }}<<{{ .Anchor }},`+{{ .Key }}+`>>{{ else }}`+{{ .Key }}+`{{ end }}{{ end }}")
+	valuesSectionBuilder.WriteString("{{ else }}")
+	valuesSectionBuilder.WriteString("{{ range .Keys }}\n* {{ if .Anchor }}<<{{ .Anchor }},`+{{ .Key }}+`>>{{ else }}`+{{ .Key }}+`{{ end }}{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesTableAdoc" }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valuesTable" . }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesSection" }}`)
+	valuesSectionBuilder.WriteString("{{ if .Values }}")
+	valuesSectionBuilder.WriteString(`{{ template "chart.valuesHeader" . }}`)
+	valuesSectionBuilder.WriteString("\n\n")
+	valuesSectionBuilder.WriteString(`{{ template "chart.valuesTable" . }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesSectionAdoc" }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valuesSection" . }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	return valuesSectionBuilder.String()
+}
+
+func getAsciiDocValuesTreeTemplates() string {
+	valuesTreeBuilder := strings.Builder{}
+
+	// Indented tree, as a nested list marked with one more asterisk per level
+	valuesTreeBuilder.WriteString(`{{ define "chart.valuesTreeNode" }}`)
+	valuesTreeBuilder.WriteString(`{{ repeat (int (add .Depth 1)) "*" }} `)
+	valuesTreeBuilder.WriteString("{{ if .Children }}*{{ .Name }}*{{ else }}`+{{ .Name }}+`{{ end }}")
+	valuesTreeBuilder.WriteString(`{{ with .Row }} ({{ .Type }}){{ if not $.Children }} {{ template "chart.valueDefaultColumnRenderAdoc" . }}{{ end }}`)
+	valuesTreeBuilder.WriteString(`{{ if or .Description .AutoDescription }} - {{ if .Description }}{{ .Description }}{{ else }}{{ .AutoDescription }}{{ end }}{{ end }}{{ end }}`)
+	valuesTreeBuilder.WriteString("{{ range .Children }}\n")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesTreeNode" . }}`)
+	valuesTreeBuilder.WriteString("{{ end }}")
+	valuesTreeBuilder.WriteString("{{ end }}")
+
+	valuesTreeBuilder.WriteString(`{{ define "chart.valuesTree" }}`)
+	valuesTreeBuilder.WriteString("{{ range .ValuesTree.Children }}\n")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesTreeNode" . }}`)
+	valuesTreeBuilder.WriteString("{{ end }}")
+	valuesTreeBuilder.WriteString("{{ end }}")
+
+	valuesTreeBuilder.WriteString(`{{ define "chart.valuesSectionTree" }}`)
+	valuesTreeBuilder.WriteString("{{ if .Values }}")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesHeader" . }}`)
+	valuesTreeBuilder.WriteString("\n")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesTree" . }}`)
+	valuesTreeBuilder.WriteString("{{ end }}")
+	valuesTreeBuilder.WriteString("{{ end }}")
+
+	return valuesTreeBuilder.String()
+}
+
+func getAsciiDocHelmDocsVersionTemplates() string {
+	versionSectionBuilder := strings.Builder{}
+	versionSectionBuilder.WriteString(`{{ define "helm-docs.version" }}{{ if .HelmDocsVersion }}{{ .HelmDocsVersion }}{{ end }}{{ end }}`)
+	versionSectionBuilder.WriteString(`{{ define "helm-docs.versionFooter" }}`)
+	versionSectionBuilder.WriteString("{{ if .HelmDocsVersion }}\n")
+	versionSectionBuilder.WriteString("'''\n\n")
+	versionSectionBuilder.WriteString("Autogenerated from chart metadata using https://github.com/norwoodj/helm-docs/releases/v{{ .HelmDocsVersion }}[helm-docs v{{ .HelmDocsVersion }}]")
+	versionSectionBuilder.WriteString("{{ end }}")
+	versionSectionBuilder.WriteString("{{ end }}")
+
+	return versionSectionBuilder.String()
+}
+
+func getAsciiDocTemplates(badgeStyle string) []string {
+	return []string{
+		getNameTemplate(),
+		getAsciiDocHeaderTemplate(),
+		getAsciiDocIconTemplates(),
+		getAsciiDocDeprecatedTemplate(),
+		getAsciiDocBadgeTemplates(badgeStyle),
+		getDescriptionTemplate(),
+		getAsciiDocSourceLinkTemplates(),
+		getAsciiDocArtifactHubTemplates(),
+		getAsciiDocCustomResourceDefinitionsTemplates(),
+		getAsciiDocNamedTemplatesTemplates(),
+		getAsciiDocRequiredValuesTemplates(),
+		getAsciiDocRenderedResourcesTemplates(),
+		getAsciiDocRBACTemplates(),
+		getAsciiDocKubeCompatibilityTemplates(),
+		getAsciiDocRequirementsTableTemplates(),
+		getAsciiDocValuesTableTemplates(),
+		getAsciiDocValuesTreeTemplates(),
+		getAsciiDocHomepageTemplate(),
+		getAsciiDocKeywordsTemplates(),
+		getAsciiDocMaintainersTemplate(),
+		getAsciiDocHelmDocsVersionTemplates(),
+	}
+}
+
+// applyAsciiDocFormat removes trailing whitespace and collapses runs of blank lines like applyMarkDownFormat, but leaves
+// the contents of delimited listing, literal, passthrough and comment blocks untouched.
+func applyAsciiDocFormat(output bytes.Buffer) bytes.Buffer {
+	lines := strings.Split(output.String(), "\n")
+	formattedLines := make([]string, 0, len(lines))
+	openBlockDelimiter := ""
+	blankLines := 0
+
+	for _, line := range lines {
+		if openBlockDelimiter != "" {
+			formattedLines = append(formattedLines, line)
+			if line == openBlockDelimiter {
+				openBlockDelimiter = ""
+			}
+			continue
+		}
+
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			blankLines++
+			if blankLines > 1 {
+				continue
+			}
+		} else {
+			blankLines = 0
+		}
+
+		if asciiDocDelimitedBlockRegex.MatchString(line) {
+			openBlockDelimiter = line
+		}
+
+		formattedLines = append(formattedLines, line)
+	}
+
+	output.Reset()
+	output.WriteString(strings.Join(formattedLines, "\n"))
+	return output
+}
+
+// applyOutputFormat post-processes rendered documentation according to its format.
+func applyOutputFormat(output bytes.Buffer, format string) bytes.Buffer {
+	if format == AsciiDocOutputFormat {
+		return applyAsciiDocFormat(output)
+	}
+
+	return applyMarkDownFormat(output)
+}
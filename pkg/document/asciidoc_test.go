@@ -0,0 +1,92 @@
+package document
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestGetDocumentFormat(t *testing.T) {
+	assert.Equal(t, AsciiDocOutputFormat, getDocumentFormat("charts/my-chart/README.adoc"))
+	assert.Equal(t, AsciiDocOutputFormat, getDocumentFormat("charts/my-chart/README.de.asciidoc"))
+	assert.Equal(t, MarkdownOutputFormat, getDocumentFormat("charts/my-chart/README.md"))
+}
+
+func TestAsciiDocTemplates(t *testing.T) {
+	info := getExportTestChartInfo(t)
+	info.ChartValuesDescriptions = map[string]helm.ChartValueDescription{
+		"image": {Description: "The image, see {registry} | docker.io"},
+	}
+
+	tpl, err := newChartDocumentationTemplate(info, info.ChartDirectory, []string{"README.adoc.gotmpl"}, "flat-square", &translator{}, AsciiDocOutputFormat)
+	require.NoError(t, err)
+
+	data, err := getChartTemplateData(info, "", nil, true, &translator{})
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	require.NoError(t, tpl.Execute(&output, data))
+	output = applyAsciiDocFormat(output)
+
+	assert.Equal(t, `= my-chart
+
+image:https://img.shields.io/badge/Version-1.0.0-informational?style=flat-square[Version: 1.0.0]
+
+== Maintainers
+
+[options="header"]
+|===
+| Name | Email | URL
+| John Doe | mailto:john@example.com[] |
+|===
+
+== Requirements
+
+[options="header"]
+|===
+| Repository | Name | Version
+| https://charts.example.com | redis | 17.0.0
+|===
+
+== Values
+
+[[scaling]]
+=== Scaling
+
+[cols="2,1,2,4",options="header"]
+|===
+| Key | Type | Default | Description
+| replicas | int | `+"`+1+`"+` | Number of replicas
+|===
+
+[[other-values]]
+=== Other Values
+
+[cols="2,1,2,4",options="header"]
+|===
+| Key | Type | Default | Description
+| image | string | `+"`+\"nginx\"+`"+` | The image, see \{registry} \| docker.io
+|===
+`, output.String())
+}
+
+func TestApplyAsciiDocFormat(t *testing.T) {
+	var output bytes.Buffer
+	output.WriteString("= Title  \n\n\n\nText\n\n----\nkey: value  \n\n\n\nother: value\n----\n\n\n")
+
+	output = applyAsciiDocFormat(output)
+	assert.Equal(t, "= Title\n\nText\n\n----\nkey: value  \n\n\n\nother: value\n----\n", output.String())
+}
+
+func TestInjectDocumentationAsciiDocMarkers(t *testing.T) {
+	existing := "= Hand written\n\n// helm-docs:start:custom.name\nold\n// helm-docs:end:custom.name\n"
+
+	output, err := injectDocumentation([]byte(existing), getInjectTestTemplate(t), getInjectTestData(), AsciiDocOutputFormat)
+	require.NoError(t, err)
+
+	assert.Equal(t, "= Hand written\n\n// helm-docs:start:custom.name\nmy-chart\n// helm-docs:end:custom.name\n", output.String())
+}
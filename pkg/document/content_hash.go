@@ -0,0 +1,65 @@
+package document
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// The hash of the generated documentation is embedded as a hidden comment on the last line of the output file, so that
+// manual edits to the file can be detected the next time it's generated. AsciiDoc files use a line comment.
+var contentHashRegex = regexp.MustCompile(`(?m)^(?:<!--|//) helm-docs:content-hash:sha256:([0-9a-f]{64})(?: -->)?\n?`)
+
+func getContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func appendContentHash(output bytes.Buffer, format string) bytes.Buffer {
+	hash := getContentHash(output.Bytes())
+	if format == AsciiDocOutputFormat {
+		output.WriteString(fmt.Sprintf("// helm-docs:content-hash:sha256:%s\n", hash))
+	} else {
+		output.WriteString(fmt.Sprintf("<!-- helm-docs:content-hash:sha256:%s -->\n", hash))
+	}
+	return output
+}
+
+// getManualEdits compares an existing output file against the content hash it embeds. If the file was edited since it
+// was generated, the returned diff shows how it differs from the newly generated documentation. Files without an
+// embedded hash, or which don't exist yet, are never considered edited.
+func getManualEdits(outputPath string, generated []byte) (string, error) {
+	existingOutput, err := os.ReadFile(outputPath)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	match := contentHashRegex.FindSubmatchIndex(existingOutput)
+	if match == nil {
+		return "", nil
+	}
+
+	body := append(append([]byte{}, existingOutput[:match[0]]...), existingOutput[match[1]:]...)
+	if getContentHash(body) == string(existingOutput[match[2]:match[3]]) {
+		return "", nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(generated)),
+		B:        difflib.SplitLines(string(body)),
+		FromFile: "generated",
+		ToFile:   outputPath,
+		Context:  3,
+	})
+	// An empty diff means the file was edited to exactly what is generated now, so there's nothing to lose
+	return diff, err
+}
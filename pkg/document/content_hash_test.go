@@ -0,0 +1,56 @@
+package document
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeGeneratedOutput(t *testing.T, content string) string {
+	var output bytes.Buffer
+	output.WriteString(content)
+	output = appendContentHash(output, MarkdownOutputFormat)
+
+	outputPath := filepath.Join(t.TempDir(), "README.md")
+	require.NoError(t, os.WriteFile(outputPath, output.Bytes(), 0o644))
+
+	return outputPath
+}
+
+func TestGetManualEditsUnchanged(t *testing.T) {
+	outputPath := writeGeneratedOutput(t, "# chart\n\nold description\n")
+
+	manualEdits, err := getManualEdits(outputPath, []byte("# chart\n\nnew description\n"))
+	require.NoError(t, err)
+	assert.Empty(t, manualEdits)
+}
+
+func TestGetManualEditsEdited(t *testing.T) {
+	outputPath := writeGeneratedOutput(t, "# chart\n\ndescription\n")
+
+	existingOutput, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(outputPath, bytes.Replace(existingOutput, []byte("description"), []byte("edited description"), 1), 0o644))
+
+	manualEdits, err := getManualEdits(outputPath, []byte("# chart\n\ndescription\n"))
+	require.NoError(t, err)
+	assert.Contains(t, manualEdits, "-description\n")
+	assert.Contains(t, manualEdits, "+edited description\n")
+}
+
+func TestGetManualEditsWithoutHash(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "README.md")
+	require.NoError(t, os.WriteFile(outputPath, []byte("# hand written\n"), 0o644))
+
+	manualEdits, err := getManualEdits(outputPath, []byte("# chart\n"))
+	require.NoError(t, err)
+	assert.Empty(t, manualEdits)
+
+	manualEdits, err = getManualEdits(filepath.Join(t.TempDir(), "missing.md"), []byte("# chart\n"))
+	require.NoError(t, err)
+	assert.Empty(t, manualEdits)
+}
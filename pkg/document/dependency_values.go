@@ -12,8 +12,11 @@ import (
 
 type DependencyValues struct {
 	Prefix                  string
+	ChartName               string
+	ImportValues            []helm.ChartImportValue
 	ChartValues             *yaml.Node
 	ChartValuesDescriptions map[string]helm.ChartValueDescription
+	ChartValuesTranslations map[string]map[string]string
 }
 
 func GetDependencyValues(root helm.ChartDocumentationInfo, allChartInfoByChartPath map[string]helm.ChartDocumentationInfo) ([]DependencyValues, error) {
@@ -45,16 +48,15 @@ func getDependencyValuesWithPrefix(root helm.ChartDocumentationInfo, allChartInf
 			continue
 		}
 
-		alias := dep.Alias
-		if alias == "" {
-			alias = dep.Name
-		}
-		depPrefix := prefix + alias
+		depPrefix := prefix + dep.GetName()
 
 		result = append(result, DependencyValues{
 			Prefix:                  depPrefix,
+			ChartName:               depInfo.Name,
+			ImportValues:            dep.GetImportValues(),
 			ChartValues:             depInfo.ChartValues,
 			ChartValuesDescriptions: depInfo.ChartValuesDescriptions,
+			ChartValuesTranslations: depInfo.ChartValuesTranslations,
 		})
 
 		children, err := getDependencyValuesWithPrefix(depInfo, allChartInfoByChartPath, depPrefix+".")
@@ -0,0 +1,361 @@
+package document
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+// effectiveDependencyValues are the values of a dependency with the overrides of the charts depending on it applied.
+type effectiveDependencyValues struct {
+	values       *yaml.Node
+	descriptions map[string]helm.ChartValueDescription
+}
+
+func resolveValueNode(node *yaml.Node) *yaml.Node {
+	if node != nil && node.Kind == yaml.AliasNode {
+		return node.Alias
+	}
+
+	return node
+}
+
+func isNullValueNode(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == nullTag
+}
+
+// getValuesMapping returns the mapping node of a values file, or nil if the values file is empty.
+func getValuesMapping(document *yaml.Node) (*yaml.Node, error) {
+	if document == nil || document.Kind == 0 {
+		return nil, nil
+	}
+
+	if document.Kind != yaml.DocumentNode {
+		return nil, fmt.Errorf("invalid node kind supplied: %d", document.Kind)
+	}
+
+	mapping := resolveValueNode(document.Content[0])
+	if mapping.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("values file must resolve to a map (was %d)", mapping.Kind)
+	}
+
+	return mapping, nil
+}
+
+// getValueNode returns the node of the value with the given key, or nil if the value isn't set.
+func getValueNode(values *yaml.Node, key string) *yaml.Node {
+	node := resolveValueNode(values)
+	if key == "" || node == nil {
+		return node
+	}
+
+	for _, segment := range helm.SplitValueKey(key) {
+		if strings.HasPrefix(segment, "[") {
+			index, err := strconv.Atoi(strings.Trim(segment, "[]"))
+			if err != nil || node.Kind != yaml.SequenceNode || index >= len(node.Content) {
+				return nil
+			}
+
+			node = resolveValueNode(node.Content[index])
+			continue
+		}
+
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		var child *yaml.Node
+		for i := 0; i < len(node.Content); i += 2 {
+			if formatNextObjectKeyPrefix("", node.Content[i].Value) == segment {
+				child = resolveValueNode(node.Content[i+1])
+				break
+			}
+		}
+
+		if child == nil {
+			return nil
+		}
+
+		node = child
+	}
+
+	return node
+}
+
+// coalesceValueNodes merges the values a parent chart sets for a dependency over the values of the dependency, the way
+// Helm coalesces them: objects are merged, other values of the parent replace those of the dependency and null values
+// of the parent remove them. Keys keep the comments of the parent, unless only the dependency documents them.
+func coalesceValueNodes(parent *yaml.Node, child *yaml.Node) *yaml.Node {
+	parent = resolveValueNode(parent)
+	child = resolveValueNode(child)
+
+	if parent == nil {
+		return child
+	}
+
+	if child == nil || parent.Kind != yaml.MappingNode || child.Kind != yaml.MappingNode {
+		return parent
+	}
+
+	parentIndices := make(map[string]int, len(parent.Content)/2)
+	for i := 0; i < len(parent.Content); i += 2 {
+		parentIndices[parent.Content[i].Value] = i
+	}
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: child.Tag, Style: child.Style}
+	for i := 0; i < len(child.Content); i += 2 {
+		key, value := child.Content[i], child.Content[i+1]
+
+		j, ok := parentIndices[key.Value]
+		if !ok {
+			merged.Content = append(merged.Content, key, value)
+			continue
+		}
+
+		delete(parentIndices, key.Value)
+		parentValue := resolveValueNode(parent.Content[j+1])
+		if isNullValueNode(parentValue) {
+			continue
+		}
+
+		if strings.Contains(parent.Content[j].HeadComment, helm.PrefixComment) {
+			key = parent.Content[j]
+		}
+
+		merged.Content = append(merged.Content, key, coalesceValueNodes(parentValue, value))
+	}
+
+	for i := 0; i < len(parent.Content); i += 2 {
+		if _, ok := parentIndices[parent.Content[i].Value]; !ok || isNullValueNode(resolveValueNode(parent.Content[i+1])) {
+			continue
+		}
+
+		merged.Content = append(merged.Content, parent.Content[i], parent.Content[i+1])
+	}
+
+	return merged
+}
+
+// getRelativeValueKey returns the given key relative to the given prefix, or false if the key isn't nested in it.
+func getRelativeValueKey(prefix string, key string) (string, bool) {
+	if prefix == "" {
+		return key, true
+	}
+
+	if strings.HasPrefix(key, prefix+".") {
+		return strings.TrimPrefix(key, prefix+"."), true
+	}
+
+	if strings.HasPrefix(key, prefix+"[") {
+		return strings.TrimPrefix(key, prefix), true
+	}
+
+	return "", false
+}
+
+func joinValueKeys(prefix string, key string) string {
+	switch {
+	case prefix == "":
+		return key
+	case key == "":
+		return prefix
+	case strings.HasPrefix(key, "["):
+		return prefix + key
+	default:
+		return prefix + "." + key
+	}
+}
+
+// getAncestorPrefixes returns the prefixes of the charts a dependency is nested in, starting with the root chart.
+func getAncestorPrefixes(prefix string, valuesByPrefix map[string]*yaml.Node) []string {
+	ancestors := []string{""}
+	for i, c := range prefix {
+		if _, ok := valuesByPrefix[prefix[:i]]; c == '.' && ok {
+			ancestors = append(ancestors, prefix[:i])
+		}
+	}
+
+	return ancestors
+}
+
+// getDependencyValueRows returns the value rows of a chart along with those of its dependencies, each with its effective
+// default. The values the chart, or a chart in between, sets for a dependency are coalesced with the values of the
+// dependency the way Helm does it, and are documented once under the key of the dependency, combining the descriptions
+// of both charts. Values imported from dependencies with import-values are documented along with the values of the
+// chart importing them. The chart a default comes from is set on rows whose default isn't set by their own chart.
+func getDependencyValueRows(info helm.ChartDocumentationInfo, valueRows []valueRow, dependencyValues []DependencyValues, t *translator) ([]valueRow, error) {
+	rootValues, err := getValuesMapping(info.ChartValues)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesByPrefix := map[string]*yaml.Node{"": rootValues}
+	descriptionsByPrefix := map[string]map[string]helm.ChartValueDescription{"": info.ChartValuesDescriptions}
+	chartNamesByPrefix := map[string]string{"": info.Name}
+
+	for _, dep := range dependencyValues {
+		depValues, err := getValuesMapping(dep.ChartValues)
+		if err != nil {
+			return nil, err
+		}
+
+		valuesByPrefix[dep.Prefix] = depValues
+		descriptionsByPrefix[dep.Prefix] = dep.ChartValuesDescriptions
+		chartNamesByPrefix[dep.Prefix] = dep.ChartName
+		if dep.ChartName == "" {
+			chartNamesByPrefix[dep.Prefix] = dep.Prefix
+		}
+	}
+
+	// Values a chart sets for its dependencies are documented along with the values of the dependencies
+	effectiveValueRows := make([]valueRow, 0, len(valueRows))
+	rowIndicesByKey := make(map[string]int, len(valueRows))
+	for _, row := range valueRows {
+		row.IsGlobal = strings.HasPrefix(row.Key, "global.")
+		if !row.IsGlobal && isValueOfNestedDependency(row, dependencyValues) {
+			continue
+		}
+
+		rowIndicesByKey[row.Key] = len(effectiveValueRows)
+		effectiveValueRows = append(effectiveValueRows, row)
+	}
+
+	appendRow := func(row valueRow) {
+		if i, ok := rowIndicesByKey[row.Key]; ok {
+			if getValueRowDescription(effectiveValueRows[i]) == "" {
+				effectiveValueRows[i].Description = row.Description
+				effectiveValueRows[i].AutoDescription = row.AutoDescription
+			}
+
+			return
+		}
+
+		rowIndicesByKey[row.Key] = len(effectiveValueRows)
+		effectiveValueRows = append(effectiveValueRows, row)
+	}
+
+	effectiveValuesByPrefix := make(map[string]effectiveDependencyValues, len(dependencyValues))
+	for _, dep := range dependencyValues {
+		effective := effectiveDependencyValues{
+			values:       valuesByPrefix[dep.Prefix],
+			descriptions: make(map[string]helm.ChartValueDescription, len(dep.ChartValuesDescriptions)),
+		}
+
+		for key, description := range dep.ChartValuesDescriptions {
+			effective.descriptions[key] = description
+		}
+
+		// Apply the overrides of the closest chart first, so that the root chart has the last word
+		ancestors := getAncestorPrefixes(dep.Prefix, valuesByPrefix)
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			relativePrefix, _ := getRelativeValueKey(ancestors[i], dep.Prefix)
+			override := getValueNode(valuesByPrefix[ancestors[i]], relativePrefix)
+			if override == nil || override.Kind != yaml.MappingNode {
+				continue
+			}
+
+			effective.values = coalesceValueNodes(override, effective.values)
+			for key, description := range descriptionsByPrefix[ancestors[i]] {
+				if relativeKey, ok := getRelativeValueKey(relativePrefix, key); ok {
+					effective.descriptions[relativeKey] = description
+				}
+			}
+		}
+
+		effectiveValuesByPrefix[dep.Prefix] = effective
+		if effective.values == nil {
+			continue
+		}
+
+		depValueRows, err := createValueRowsFromField("", nil, effective.values, effective.descriptions, true)
+		if err != nil {
+			return nil, err
+		}
+
+		t.localizeValueRows(depValueRows, dep.ChartValuesTranslations[t.language])
+
+		for _, row := range depValueRows {
+			if row.Key == "global" || strings.HasPrefix(row.Key, "global.") {
+				row.IsGlobal = true
+				row.Dependency = dep.Prefix
+				appendRow(row)
+				continue
+			}
+
+			for _, ancestor := range ancestors {
+				relativePrefix, _ := getRelativeValueKey(ancestor, dep.Prefix)
+				if getValueNode(valuesByPrefix[ancestor], joinValueKeys(relativePrefix, row.Key)) != nil {
+					row.DefaultFrom = chartNamesByPrefix[ancestor]
+					break
+				}
+			}
+
+			row.Key = dep.Prefix + "." + row.Key
+			row.Dependency = dep.Prefix
+			if !isValueOfNestedDependency(row, dependencyValues) {
+				appendRow(row)
+			}
+		}
+	}
+
+	for _, dep := range dependencyValues {
+		parentPrefix := ""
+		if i := strings.LastIndex(dep.Prefix, "."); i >= 0 {
+			parentPrefix = dep.Prefix[:i]
+		}
+
+		effective := effectiveValuesByPrefix[dep.Prefix]
+		for _, importValue := range dep.ImportValues {
+			imported := getValueNode(effective.values, importValue.Child)
+			if imported == nil || imported.Kind != yaml.MappingNode {
+				log.Debugf("Value %s imported from dependency %s is not an object, leaving it out of the documentation", importValue.Child, dep.Prefix)
+				continue
+			}
+
+			target := joinValueKeys(parentPrefix, importValue.Parent)
+			descriptions := make(map[string]helm.ChartValueDescription)
+			for key, description := range effective.descriptions {
+				if relativeKey, ok := getRelativeValueKey(importValue.Child, key); ok {
+					descriptions[joinValueKeys(target, relativeKey)] = description
+				}
+			}
+
+			importedValueRows, err := createValueRowsFromField(target, &yaml.Node{}, imported, descriptions, true)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, row := range importedValueRows {
+				row.Dependency = parentPrefix
+				row.DefaultFrom = chartNamesByPrefix[dep.Prefix]
+				appendRow(row)
+			}
+		}
+	}
+
+	return effectiveValueRows, nil
+}
+
+// isValueOfNestedDependency returns whether a value row documents a value of a dependency of the chart it belongs to.
+func isValueOfNestedDependency(row valueRow, dependencyValues []DependencyValues) bool {
+	for _, dep := range dependencyValues {
+		if dep.Prefix == row.Dependency {
+			continue
+		}
+
+		if _, nested := getRelativeValueKey(row.Dependency, dep.Prefix); !nested {
+			continue
+		}
+
+		if _, ok := getRelativeValueKey(dep.Prefix, row.Key); ok {
+			return true
+		}
+	}
+
+	return false
+}
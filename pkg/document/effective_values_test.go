@@ -0,0 +1,168 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func getEffectiveValuesTestRows(t *testing.T) map[string]valueRow {
+	rootValues := parseYamlValues(`
+global:
+  # -- Registry of all images
+  registry: docker.io
+
+# -- Name of the umbrella
+name: umbrella
+
+redis:
+  # -- Replicas of redis, reduced for the umbrella
+  replicas: 1
+  auth:
+    password: null
+  metrics:
+    sidecar:
+      enabled: true
+	`)
+
+	redisValues := parseYamlValues(`
+global:
+  registry: ""
+
+# -- Number of replicas
+replicas: 3
+
+auth:
+  # -- Password of the default user
+  password: secret
+
+# -- Port of redis
+port: 6379
+
+metrics:
+  # -- Whether to export metrics
+  enabled: false
+
+exports:
+  connection:
+    # -- Host to connect to redis
+    host: redis
+	`)
+
+	sidecarValues := parseYamlValues(`
+# -- Whether to run the sidecar
+enabled: false
+	`)
+
+	document := func(values *yaml.Node) *yaml.Node {
+		return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{values}}
+	}
+
+	info := helm.ChartDocumentationInfo{
+		ChartMeta:               helm.ChartMeta{Name: "umbrella"},
+		ChartDirectory:          t.TempDir(),
+		ChartValues:             document(rootValues),
+		ChartValuesDescriptions: map[string]helm.ChartValueDescription{"redis.metrics.sidecar.enabled": {Description: "Runs the sidecar"}},
+	}
+
+	dependencyValues := []DependencyValues{
+		{
+			Prefix:                  "redis",
+			ChartName:               "redis",
+			ImportValues:            []helm.ChartImportValue{{Child: "exports.connection", Parent: "redis-connection"}},
+			ChartValues:             document(redisValues),
+			ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+		},
+		{
+			Prefix:                  "redis.metrics.sidecar",
+			ChartName:               "sidecar",
+			ChartValues:             document(sidecarValues),
+			ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+		},
+	}
+
+	data, err := getChartTemplateData(info, "", dependencyValues, true, &translator{})
+	require.NoError(t, err)
+
+	rowsByKey := make(map[string]valueRow, len(data.Values))
+	for _, row := range data.Values {
+		_, duplicate := rowsByKey[row.Key]
+		assert.False(t, duplicate, "value %s is documented twice", row.Key)
+		rowsByKey[row.Key] = row
+	}
+
+	return rowsByKey
+}
+
+func TestCoalesceValueNodes(t *testing.T) {
+	parent := parseYamlValues(`
+replicas: 1
+auth:
+  password: null
+labels:
+  team: a
+	`)
+
+	child := parseYamlValues(`
+replicas: 3
+auth:
+  password: secret
+  user: default
+port: 6379
+	`)
+
+	var coalesced map[string]interface{}
+	require.NoError(t, coalesceValueNodes(parent, child).Decode(&coalesced))
+
+	assert.Equal(t, map[string]interface{}{
+		"replicas": 1,
+		"auth":     map[string]interface{}{"user": "default"},
+		"port":     6379,
+		"labels":   map[string]interface{}{"team": "a"},
+	}, coalesced)
+}
+
+func TestEffectiveDependencyValueDefaults(t *testing.T) {
+	rows := getEffectiveValuesTestRows(t)
+
+	assert.Equal(t, "`1`", rows["redis.replicas"].Default)
+	assert.Equal(t, "umbrella", rows["redis.replicas"].DefaultFrom)
+	assert.Equal(t, "Replicas of redis, reduced for the umbrella", rows["redis.replicas"].AutoDescription)
+	assert.Equal(t, "redis", rows["redis.replicas"].Dependency)
+
+	assert.Equal(t, "`6379`", rows["redis.port"].Default)
+	assert.Empty(t, rows["redis.port"].DefaultFrom)
+	assert.Equal(t, "Port of redis", rows["redis.port"].AutoDescription)
+
+	assert.NotContains(t, rows, "redis.auth.password")
+	assert.Equal(t, "`false`", rows["redis.metrics.enabled"].Default)
+}
+
+func TestEffectiveNestedDependencyValueDefaults(t *testing.T) {
+	rows := getEffectiveValuesTestRows(t)
+
+	row := rows["redis.metrics.sidecar.enabled"]
+	assert.Equal(t, "`true`", row.Default)
+	assert.Equal(t, "umbrella", row.DefaultFrom)
+	assert.Equal(t, "redis.metrics.sidecar", row.Dependency)
+	assert.Equal(t, "Runs the sidecar", row.Description)
+	assert.Equal(t, "Whether to run the sidecar", row.AutoDescription)
+}
+
+func TestEffectiveGlobalAndImportedValues(t *testing.T) {
+	rows := getEffectiveValuesTestRows(t)
+
+	assert.Equal(t, "`\"docker.io\"`", rows["global.registry"].Default)
+	assert.True(t, rows["global.registry"].IsGlobal)
+	assert.Empty(t, rows["global.registry"].Dependency)
+
+	row := rows["redis-connection.host"]
+	assert.Equal(t, "`\"redis\"`", row.Default)
+	assert.Equal(t, "redis", row.DefaultFrom)
+	assert.Empty(t, row.Dependency)
+	assert.Equal(t, "Host to connect to redis", row.AutoDescription)
+}
@@ -0,0 +1,546 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+type exampleValuesWriter struct {
+	output       bytes.Buffer
+	descriptions map[string]helm.ChartValueDescription
+	rowsByKey    map[string]valueRow
+	sortOrder    string
+
+	// Keys of optional values, those which are documented but not set in the values file, keyed by the key of the
+	// closest object in the values file they are nested in
+	optionalKeys map[string][]string
+
+	// Section of every value and of every key values are nested in, see getKeySections
+	keySections map[string]string
+}
+
+// copyExampleValueNode returns a deep copy of a values file node without its comments and anchors, with aliases
+// replaced by the nodes they refer to.
+func copyExampleValueNode(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		return copyExampleValueNode(node.Alias)
+	}
+
+	nodeCopy := &yaml.Node{Kind: node.Kind, Style: node.Style, Tag: node.Tag, Value: node.Value}
+	for _, child := range node.Content {
+		nodeCopy.Content = append(nodeCopy.Content, copyExampleValueNode(child))
+	}
+
+	return nodeCopy
+}
+
+func encodeExampleValueNode(node *yaml.Node) ([]string, error) {
+	var encoded bytes.Buffer
+	encoder := yaml.NewEncoder(&encoded)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return strings.Split(strings.TrimRight(encoded.String(), "\n"), "\n"), nil
+}
+
+// findOptionalValueParent returns the key of the closest object or null value in the values file the value with the
+// given key is nested in, or false if the value is set in the values file itself.
+func findOptionalValueParent(values *yaml.Node, key string) (string, bool) {
+	segments := helm.SplitValueKey(key)
+	node := values
+	parent := ""
+
+	for depth, segment := range segments {
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+
+		if node.Kind != yaml.MappingNode {
+			return parent, true
+		}
+
+		var child *yaml.Node
+		for i := 0; i < len(node.Content); i += 2 {
+			if formatNextObjectKeyPrefix("", node.Content[i].Value) == segment {
+				child = node.Content[i+1]
+				break
+			}
+		}
+
+		if child == nil {
+			return parent, true
+		}
+
+		if child.Kind == yaml.MappingNode || (child.Kind == yaml.ScalarNode && child.Tag == nullTag) {
+			parent = helm.JoinValueKey(segments[:depth+1])
+		}
+
+		node = child
+	}
+
+	return "", false
+}
+
+func (w *exampleValuesWriter) writeLine(indent string, line string) {
+	w.output.WriteString(strings.TrimRight(indent+line, " "))
+	w.output.WriteString("\n")
+}
+
+// writeComment writes the documentation of a value as helm-docs comments, so that the example values file can be
+// documented itself.
+func (w *exampleValuesWriter) writeComment(indent string, row valueRow, withExample bool) {
+	description := getValueRowDescription(row)
+	customDefault := row.AutoDefault
+	if customDefault == "" {
+		customDefault = w.descriptions[row.Key].Default
+	}
+
+	withExample = withExample && row.Example != ""
+	if description == "" && customDefault == "" && row.Section == "" && !row.Deprecated && !withExample {
+		return
+	}
+
+	descriptionLines := strings.Split(description, "\n")
+	w.writeLine(indent, "# -- "+descriptionLines[0])
+	if len(descriptionLines) > 1 {
+		w.writeLine(indent, "# @raw")
+		for _, line := range descriptionLines[1:] {
+			w.writeLine(indent, "# "+line)
+		}
+	}
+
+	if customDefault != "" {
+		w.writeLine(indent, "# @default -- "+customDefault)
+	}
+
+	if row.Section != "" {
+		w.writeLine(indent, "# @section -- "+row.Section)
+	}
+
+	if row.Deprecated {
+		if row.DeprecationMessage != "" {
+			w.writeLine(indent, "# @deprecated -- "+row.DeprecationMessage)
+		} else {
+			w.writeLine(indent, "# @deprecated")
+		}
+	}
+
+	if withExample {
+		w.writeLine(indent, "# @example")
+		for _, line := range strings.Split(row.Example, "\n") {
+			w.writeLine(indent, "# "+line)
+		}
+	}
+}
+
+// sortedMappingKeys returns the indices of the keys of a mapping node, in the configured sort order.
+func (w *exampleValuesWriter) sortedMappingKeys(mapping *yaml.Node) []int {
+	keys := make([]int, 0, len(mapping.Content)/2)
+	for i := 0; i < len(mapping.Content); i += 2 {
+		keys = append(keys, i)
+	}
+
+	if w.sortOrder == AlphaNumSortOrder {
+		sort.SliceStable(keys, func(i, j int) bool {
+			return mapping.Content[keys[i]].Value < mapping.Content[keys[j]].Value
+		})
+	}
+
+	return keys
+}
+
+// writeMapping writes the fields of a mapping in the given section. Fields belonging to other sections, because values
+// nested in the mapping have @section comments of their own, are grouped under a heading for their section. Fields
+// without a section are kept in the section of the mapping.
+func (w *exampleValuesWriter) writeMapping(prefix string, mapping *yaml.Node, depth int, section string) error {
+	indent := strings.Repeat("  ", depth)
+	sectionOf := func(key string) string {
+		if keySection := w.keySections[key]; keySection != "" {
+			return keySection
+		}
+
+		return section
+	}
+
+	fieldSections := []string{section}
+	seenFieldSections := map[string]bool{section: true}
+	otherFieldSections := make([]string, 0)
+
+	for _, i := range w.sortedMappingKeys(mapping) {
+		fieldSection := sectionOf(formatNextObjectKeyPrefix(prefix, mapping.Content[i].Value))
+		if !seenFieldSections[fieldSection] {
+			seenFieldSections[fieldSection] = true
+			otherFieldSections = append(otherFieldSections, fieldSection)
+		}
+	}
+
+	for _, key := range w.optionalKeys[prefix] {
+		if !seenFieldSections[sectionOf(key)] {
+			seenFieldSections[sectionOf(key)] = true
+			otherFieldSections = append(otherFieldSections, sectionOf(key))
+		}
+	}
+
+	fieldSections = append(fieldSections, sortExampleSectionNames(otherFieldSections)...)
+	for _, fieldSection := range fieldSections {
+		if fieldSection != section {
+			w.writeLine("", "")
+			w.writeLine(indent, "## "+getExampleSectionName(fieldSection))
+		}
+
+		for _, i := range w.sortedMappingKeys(mapping) {
+			if sectionOf(formatNextObjectKeyPrefix(prefix, mapping.Content[i].Value)) != fieldSection {
+				continue
+			}
+
+			if err := w.writeField(prefix, mapping.Content[i], mapping.Content[i+1], depth, fieldSection); err != nil {
+				return err
+			}
+		}
+
+		optionalKeys := make([]string, 0)
+		for _, key := range w.optionalKeys[prefix] {
+			if sectionOf(key) == fieldSection {
+				optionalKeys = append(optionalKeys, key)
+			}
+		}
+
+		w.writeOptionalValues(prefix, optionalKeys, depth)
+	}
+
+	return nil
+}
+
+func (w *exampleValuesWriter) writeField(prefix string, key *yaml.Node, value *yaml.Node, depth int, section string) error {
+	valueKey := formatNextObjectKeyPrefix(prefix, key.Value)
+	indent := strings.Repeat("  ", depth)
+
+	if row, ok := w.rowsByKey[valueKey]; ok {
+		w.writeComment(indent, row, true)
+	}
+
+	if value.Kind == yaml.AliasNode {
+		value = value.Alias
+	}
+
+	if value.Kind == yaml.MappingNode && len(value.Content) > 0 {
+		encodedKey, err := encodeExampleValueNode(copyExampleValueNode(key))
+		if err != nil {
+			return err
+		}
+
+		w.writeLine(indent, encodedKey[0]+":")
+		return w.writeMapping(valueKey, value, depth+1, section)
+	}
+
+	if value.Kind == yaml.SequenceNode && value.Style&yaml.FlowStyle == 0 && len(value.Content) > 0 {
+		encodedKey, err := encodeExampleValueNode(copyExampleValueNode(key))
+		if err != nil {
+			return err
+		}
+
+		w.writeLine(indent, encodedKey[0]+":")
+		return w.writeSequence(valueKey, value, depth+1, section)
+	}
+
+	encodedField, err := encodeExampleValueNode(&yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: []*yaml.Node{copyExampleValueNode(key), copyExampleValueNode(value)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode value %s: %s", valueKey, err)
+	}
+
+	for _, line := range encodedField {
+		w.writeLine(indent, line)
+	}
+
+	w.writeOptionalValues(valueKey, w.optionalKeys[valueKey], depth+1)
+	return nil
+}
+
+// writeSequence writes the items of a list one by one, so that the documentation of each item and of the values nested
+// in it is kept as comments.
+func (w *exampleValuesWriter) writeSequence(prefix string, sequence *yaml.Node, depth int, section string) error {
+	indent := strings.Repeat("  ", depth)
+
+	for index, item := range sequence.Content {
+		itemKey := formatNextListKeyPrefix(prefix, index)
+		if row, ok := w.rowsByKey[itemKey]; ok {
+			w.writeComment(indent, row, true)
+		}
+
+		if item.Kind == yaml.AliasNode {
+			item = item.Alias
+		}
+
+		itemWriter := &exampleValuesWriter{
+			descriptions: w.descriptions,
+			rowsByKey:    w.rowsByKey,
+			sortOrder:    w.sortOrder,
+			optionalKeys: w.optionalKeys,
+			keySections:  w.keySections,
+		}
+
+		var err error
+		switch {
+		case item.Kind == yaml.MappingNode && len(item.Content) > 0:
+			err = itemWriter.writeMapping(itemKey, item, depth+1, section)
+		case item.Kind == yaml.SequenceNode && item.Style&yaml.FlowStyle == 0 && len(item.Content) > 0:
+			err = itemWriter.writeSequence(itemKey, item, depth+1, section)
+		default:
+			var encodedItem []string
+			encodedItem, err = encodeExampleValueNode(copyExampleValueNode(item))
+			for _, line := range encodedItem {
+				itemWriter.writeLine(indent+"  ", line)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// The item is written after its dash, unless its first line is the documentation of a value nested in it
+		itemLines := strings.Split(strings.TrimSuffix(itemWriter.output.String(), "\n"), "\n")
+		if strings.HasPrefix(strings.TrimSpace(itemLines[0]), "#") {
+			w.writeLine(indent, "-")
+		} else {
+			w.writeLine(indent, "- "+strings.TrimPrefix(itemLines[0], indent+"  "))
+			itemLines = itemLines[1:]
+		}
+
+		for _, line := range itemLines {
+			w.writeLine("", line)
+		}
+	}
+
+	return nil
+}
+
+// writeOptionalValues writes the optional values nested in the given parent commented out, with their example if one
+// was given.
+func (w *exampleValuesWriter) writeOptionalValues(parent string, keys []string, depth int) {
+	indent := strings.Repeat("  ", depth)
+	parentDepth := 0
+	if parent != "" {
+		parentDepth = len(helm.SplitValueKey(parent))
+	}
+
+	for _, key := range keys {
+		segments := helm.SplitValueKey(key)[parentDepth:]
+		if strings.Contains(key, "[") {
+			log.Debugf("Optional value %s is nested in a list, leaving it out of the example values", key)
+			continue
+		}
+
+		row, err := createValueRow(key, nil, w.descriptions[key], helm.ChartValueDescription{}, 0, 0)
+		if err != nil {
+			log.Debugf("Failed to document optional value %s in the example values: %s", key, err)
+			continue
+		}
+
+		w.writeComment(indent, row, false)
+		for i, segment := range segments {
+			line := "# " + strings.Repeat("  ", i) + segment + ":"
+			if i == len(segments)-1 && row.Example != "" && !strings.Contains(row.Example, "\n") {
+				line += " " + row.Example
+			}
+
+			w.writeLine(indent, line)
+		}
+
+		if strings.Contains(row.Example, "\n") {
+			exampleIndent := strings.Repeat("  ", len(segments))
+			for _, line := range strings.Split(row.Example, "\n") {
+				w.writeLine(indent, "# "+exampleIndent+line)
+			}
+		}
+	}
+}
+
+// getKeySections returns the section of every value and of every key values are nested in, which is the section of
+// the first value nested in it.
+func (w *exampleValuesWriter) getKeySections(valueRows []valueRow) map[string]string {
+	sortedRows := make([]valueRow, len(valueRows))
+	copy(sortedRows, valueRows)
+	sortValueRowsByOrder(sortedRows, w.sortOrder)
+
+	keySections := make(map[string]string)
+	setKeySections := func(key string, section string) {
+		segments := helm.SplitValueKey(key)
+		for i := range segments {
+			prefix := helm.JoinValueKey(segments[:i+1])
+			if _, ok := keySections[prefix]; !ok {
+				keySections[prefix] = section
+			}
+		}
+	}
+
+	for _, row := range sortedRows {
+		setKeySections(row.Key, row.Section)
+	}
+
+	optionalKeys := make([]string, 0)
+	for _, keys := range w.optionalKeys {
+		optionalKeys = append(optionalKeys, keys...)
+	}
+
+	sort.Strings(optionalKeys)
+	for _, key := range optionalKeys {
+		setKeySections(key, w.descriptions[key].Section)
+	}
+
+	return keySections
+}
+
+// sortExampleSectionNames orders the given sections by the configured sections order, keeping the values without a
+// section last.
+func sortExampleSectionNames(sectionNames []string) []string {
+	sortedSectionNames := make([]string, 0, len(sectionNames))
+	withoutSection := false
+	for _, sectionName := range sectionNames {
+		if sectionName == "" {
+			withoutSection = true
+			continue
+		}
+
+		sortedSectionNames = append(sortedSectionNames, sectionName)
+	}
+
+	if getSectionsSortOrder() == AlphaNumSortOrder {
+		sort.Strings(sortedSectionNames)
+	}
+
+	if withoutSection {
+		sortedSectionNames = append(sortedSectionNames, "")
+	}
+
+	return sortedSectionNames
+}
+
+func getExampleSectionName(section string) string {
+	if section == "" {
+		return "Other Values"
+	}
+
+	return section
+}
+
+// RenderExampleValues renders an example values file for a chart, which contains every value of its values file with
+// its documentation as comments, including its @section and that of list items, and its default, ordered by the
+// configured sort order. Values which are documented but not set in the values file are included commented out. If any
+// @section comments are used, top-level keys are grouped by the section of the first value nested in them, and the keys
+// nested in them with another @section are grouped by theirs within their object.
+func RenderExampleValues(info helm.ChartDocumentationInfo) ([]byte, error) {
+	valueRows, err := getUnsortedValueRows(info.ChartValues, info.ChartValuesDescriptions)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &exampleValuesWriter{
+		descriptions: info.ChartValuesDescriptions,
+		rowsByKey:    make(map[string]valueRow, len(valueRows)),
+		sortOrder:    getValuesSortOrder(),
+		optionalKeys: make(map[string][]string),
+	}
+
+	for _, row := range valueRows {
+		w.rowsByKey[row.Key] = row
+	}
+
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if info.ChartValues != nil && info.ChartValues.Kind == yaml.DocumentNode {
+		root = info.ChartValues.Content[0]
+	}
+
+	for key := range info.ChartValuesDescriptions {
+		// Annotations like "# @section -- Name" following old-style comments are parsed as descriptions of their own
+		if _, ok := w.rowsByKey[key]; ok || strings.HasPrefix(key, "@") {
+			continue
+		}
+
+		if parent, optional := findOptionalValueParent(root, key); optional {
+			w.optionalKeys[parent] = append(w.optionalKeys[parent], key)
+		}
+	}
+
+	for parent := range w.optionalKeys {
+		sort.Strings(w.optionalKeys[parent])
+	}
+
+	w.keySections = w.getKeySections(valueRows)
+	sectionNames := make([]string, 0)
+	seenSectionNames := map[string]bool{"": true}
+
+	for i := 0; i < len(root.Content); i += 2 {
+		section := w.keySections[formatNextObjectKeyPrefix("", root.Content[i].Value)]
+		if !seenSectionNames[section] {
+			seenSectionNames[section] = true
+			sectionNames = append(sectionNames, section)
+		}
+	}
+
+	for _, key := range w.optionalKeys[""] {
+		section := w.keySections[key]
+		if !seenSectionNames[section] {
+			seenSectionNames[section] = true
+			sectionNames = append(sectionNames, section)
+		}
+	}
+
+	sectionNames = sortExampleSectionNames(sectionNames)
+
+	w.writeLine("", fmt.Sprintf("# Example values for the %s chart, generated by helm-docs.", info.Name))
+	w.writeLine("", "# Every value is listed with its default, optional values are commented out.")
+
+	for _, section := range append(sectionNames, "") {
+		sectionHeaderWritten := false
+		writeSectionHeader := func() {
+			if sectionHeaderWritten || len(sectionNames) == 0 {
+				return
+			}
+
+			w.writeLine("", "")
+			w.writeLine("", "## "+getExampleSectionName(section))
+			sectionHeaderWritten = true
+		}
+
+		for _, i := range w.sortedMappingKeys(root) {
+			if w.keySections[formatNextObjectKeyPrefix("", root.Content[i].Value)] != section {
+				continue
+			}
+
+			writeSectionHeader()
+			w.writeLine("", "")
+			if err := w.writeField("", root.Content[i], root.Content[i+1], 0, section); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, key := range w.optionalKeys[""] {
+			if w.keySections[key] != section {
+				continue
+			}
+
+			writeSectionHeader()
+			w.writeLine("", "")
+			w.writeOptionalValues("", []string{key}, 0)
+		}
+	}
+
+	return w.output.Bytes(), nil
+}
@@ -0,0 +1,279 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func getExampleValuesTestChartInfo(descriptions map[string]helm.ChartValueDescription) helm.ChartDocumentationInfo {
+	helmValues := parseYamlValues(`
+# -- Number of replicas
+# @section -- Scaling
+replicas: 1
+
+image:
+  # -- Tag of the image
+  # @default -- the chart's appVersion
+  # @section -- Image
+  tag: ""
+  # -- Repository of the image
+  # @section -- Image
+  repository: nginx
+
+# -- Extra volumes
+# @example
+# - name: cache
+#   emptyDir: {}
+extraVolumes: []
+
+# -- Legacy port
+# @deprecated -- use service.port
+port: 80
+
+resources: {}
+	`)
+
+	return helm.ChartDocumentationInfo{
+		ChartMeta:               helm.ChartMeta{Name: "my-chart"},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: descriptions,
+	}
+}
+
+func TestRenderExampleValues(t *testing.T) {
+	viper.Set("sort-values-order", AlphaNumSortOrder)
+	viper.Set("sort-sections-order", AlphaNumSortOrder)
+	defer viper.Reset()
+
+	info := getExampleValuesTestChartInfo(map[string]helm.ChartValueDescription{
+		"resources.limits": {Description: "Resource limits", Example: "cpu: 100m\nmemory: 128Mi"},
+		"extraArgs":        {Description: "Extra arguments", Example: `["--v=2"]`},
+		"image.pullPolicy": {Description: "Pull policy", Section: "Image"},
+	})
+
+	exampleValues, err := RenderExampleValues(info)
+	require.NoError(t, err)
+
+	assert.Equal(t, `# Example values for the my-chart chart, generated by helm-docs.
+# Every value is listed with its default, optional values are commented out.
+
+## Image
+
+image:
+  # -- Repository of the image
+  # @section -- Image
+  repository: nginx
+  # -- Tag of the image
+  # @default -- the chart's appVersion
+  # @section -- Image
+  tag: ""
+  # -- Pull policy
+  # @section -- Image
+  # pullPolicy:
+
+## Scaling
+
+# -- Number of replicas
+# @section -- Scaling
+replicas: 1
+
+## Other Values
+
+# -- Extra volumes
+# @example
+# - name: cache
+#   emptyDir: {}
+extraVolumes: []
+
+# -- Legacy port
+# @deprecated -- use service.port
+port: 80
+
+resources: {}
+  # -- Resource limits
+  # limits:
+  #   cpu: 100m
+  #   memory: 128Mi
+
+# -- Extra arguments
+# extraArgs: ["--v=2"]
+`, string(exampleValues))
+
+	var parsed map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(exampleValues, &parsed))
+	assert.Equal(t, map[string]interface{}{"tag": "", "repository": "nginx"}, parsed["image"])
+}
+
+func TestRenderExampleValuesFileOrderWithoutSections(t *testing.T) {
+	viper.Set("sort-values-order", FileSortOrder)
+	viper.Set("sort-sections-order", FileSortOrder)
+	defer viper.Reset()
+
+	helmValues := parseYamlValues(`
+zone: eu
+# -- Name
+name: my-name
+	`)
+
+	exampleValues, err := RenderExampleValues(helm.ChartDocumentationInfo{
+		ChartMeta:               helm.ChartMeta{Name: "my-chart"},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `# Example values for the my-chart chart, generated by helm-docs.
+# Every value is listed with its default, optional values are commented out.
+
+zone: eu
+
+# -- Name
+name: my-name
+`, string(exampleValues))
+}
+
+func TestRenderExampleValuesKeepsListItemComments(t *testing.T) {
+	viper.Set("sort-values-order", FileSortOrder)
+	viper.Set("sort-sections-order", FileSortOrder)
+	defer viper.Reset()
+
+	helmValues := parseYamlValues(`
+# -- Containers run before the application
+# @section -- Startup
+initContainers:
+  # -- Waits for the database
+  - name: wait
+    # -- Image of the init container
+    # @section -- Startup
+    image: busybox
+  - name: migrate
+    args: [--all]
+
+hosts:
+  - example.com
+	`)
+
+	info := helm.ChartDocumentationInfo{
+		ChartMeta:               helm.ChartMeta{Name: "my-chart"},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+	}
+
+	exampleValues, err := RenderExampleValues(info)
+	require.NoError(t, err)
+
+	assert.Equal(t, `# Example values for the my-chart chart, generated by helm-docs.
+# Every value is listed with its default, optional values are commented out.
+
+## Startup
+
+# -- Containers run before the application
+# @section -- Startup
+initContainers:
+  # -- Waits for the database
+  - name: wait
+    # -- Image of the init container
+    # @section -- Startup
+    image: busybox
+  - name: migrate
+    args: [--all]
+
+## Other Values
+
+hosts:
+  - example.com
+`, string(exampleValues))
+
+	// The example values file documents the values the same way as the values file it was generated from
+	var exampleNode yaml.Node
+	require.NoError(t, yaml.Unmarshal(exampleValues, &exampleNode))
+	info.ChartValues = &exampleNode
+
+	exampleRows, err := getUnsortedValueRows(info.ChartValues, info.ChartValuesDescriptions)
+	require.NoError(t, err)
+	valueRows, err := getUnsortedValueRows(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}}, info.ChartValuesDescriptions)
+	require.NoError(t, err)
+
+	for i := range valueRows {
+		valueRows[i].LineNumber, valueRows[i].Column = 0, 0
+		exampleRows[i].LineNumber, exampleRows[i].Column = 0, 0
+	}
+	assert.Equal(t, valueRows, exampleRows)
+}
+
+func TestRenderExampleValuesNestedSections(t *testing.T) {
+	viper.Set("sort-values-order", FileSortOrder)
+	viper.Set("sort-sections-order", FileSortOrder)
+	defer viper.Reset()
+
+	helmValues := parseYamlValues(`
+controller:
+  # -- Name of the controller
+  # @section -- Controller
+  name: controller
+  image:
+    tag: "1.0"
+  # -- Number of replicas
+  # @section -- Scaling
+  replicas: 1
+
+# -- Whether to create an ingress
+ingress: false
+	`)
+
+	info := helm.ChartDocumentationInfo{
+		ChartMeta:               helm.ChartMeta{Name: "my-chart"},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: map[string]helm.ChartValueDescription{"controller.maxReplicas": {Description: "Maximum number of replicas", Section: "Scaling"}},
+	}
+
+	exampleValues, err := RenderExampleValues(info)
+	require.NoError(t, err)
+
+	// Values nested in the controller with a section of their own are grouped by their section within it, values without
+	// a section stay in the section of the controller
+	assert.Equal(t, `# Example values for the my-chart chart, generated by helm-docs.
+# Every value is listed with its default, optional values are commented out.
+
+## Controller
+
+controller:
+  # -- Name of the controller
+  # @section -- Controller
+  name: controller
+  image:
+    tag: "1.0"
+
+  ## Scaling
+  # -- Number of replicas
+  # @section -- Scaling
+  replicas: 1
+  # -- Maximum number of replicas
+  # @section -- Scaling
+  # maxReplicas:
+
+## Other Values
+
+# -- Whether to create an ingress
+ingress: false
+`, string(exampleValues))
+
+	var exampleNode yaml.Node
+	require.NoError(t, yaml.Unmarshal(exampleValues, &exampleNode))
+	exampleRows, err := getUnsortedValueRows(&exampleNode, info.ChartValuesDescriptions)
+	require.NoError(t, err)
+	valueRows, err := getUnsortedValueRows(info.ChartValues, info.ChartValuesDescriptions)
+	require.NoError(t, err)
+
+	for i := range valueRows {
+		valueRows[i].LineNumber, valueRows[i].Column = 0, 0
+		exampleRows[i].LineNumber, exampleRows[i].Column = 0, 0
+	}
+	assert.Equal(t, valueRows, exampleRows)
+}
@@ -0,0 +1,658 @@
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+const (
+	MarkdownOutputFormat = "markdown"
+	AsciiDocOutputFormat = "asciidoc"
+	JSONOutputFormat     = "json"
+	YAMLOutputFormat     = "yaml"
+)
+
+// DocumentationModelSchemaVersion identifies the structure of the exported documentation model. It changes whenever a
+// field is removed or changes meaning, fields may be added within the same version.
+const DocumentationModelSchemaVersion = "helm-docs/v1"
+
+// RepositoryDocumentationModel is the exported documentation of every chart found in the chart search root.
+type RepositoryDocumentationModel struct {
+	SchemaVersion   string                    `json:"schemaVersion" yaml:"schemaVersion"`
+	HelmDocsVersion string                    `json:"helmDocsVersion,omitempty" yaml:"helmDocsVersion,omitempty"`
+	Charts          []ChartDocumentationModel `json:"charts" yaml:"charts"`
+}
+
+// ChartDocumentationModel is the exported documentation of a single chart, i.e. everything the built-in templates render.
+type ChartDocumentationModel struct {
+	SchemaVersion   string            `json:"schemaVersion,omitempty" yaml:"schemaVersion,omitempty"`
+	HelmDocsVersion string            `json:"helmDocsVersion,omitempty" yaml:"helmDocsVersion,omitempty"`
+	Directory       string            `json:"directory" yaml:"directory"`
+	Chart           ChartModel        `json:"chart" yaml:"chart"`
+	Dependencies    []DependencyModel `json:"dependencies" yaml:"dependencies"`
+	Values          []ValueModel      `json:"values" yaml:"values"`
+	Sections        []SectionModel    `json:"sections" yaml:"sections"`
+
+	CustomResourceDefinitions []CustomResourceDefinitionModel `json:"customResourceDefinitions,omitempty" yaml:"customResourceDefinitions,omitempty"`
+	NamedTemplates            []NamedTemplateModel            `json:"namedTemplates,omitempty" yaml:"namedTemplates,omitempty"`
+	RenderedResources         []RenderedResourceModel         `json:"renderedResources,omitempty" yaml:"renderedResources,omitempty"`
+	RBAC                      *RBACModel                      `json:"rbac,omitempty" yaml:"rbac,omitempty"`
+	KubernetesCompatibility   []KubernetesCompatibilityModel  `json:"kubernetesCompatibility,omitempty" yaml:"kubernetesCompatibility,omitempty"`
+	ArtifactHub               *ArtifactHubModel               `json:"artifactHub,omitempty" yaml:"artifactHub,omitempty"`
+}
+
+type ChartModel struct {
+	Name        string            `json:"name" yaml:"name"`
+	Version     string            `json:"version" yaml:"version"`
+	AppVersion  string            `json:"appVersion,omitempty" yaml:"appVersion,omitempty"`
+	ApiVersion  string            `json:"apiVersion" yaml:"apiVersion"`
+	KubeVersion string            `json:"kubeVersion,omitempty" yaml:"kubeVersion,omitempty"`
+	Type        string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Home        string            `json:"home,omitempty" yaml:"home,omitempty"`
+	Deprecated  bool              `json:"deprecated" yaml:"deprecated"`
+	Sources     []string          `json:"sources" yaml:"sources"`
+	Maintainers []MaintainerModel `json:"maintainers" yaml:"maintainers"`
+	Icon        string            `json:"icon,omitempty" yaml:"icon,omitempty"`
+	Keywords    []string          `json:"keywords" yaml:"keywords"`
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+}
+
+type MaintainerModel struct {
+	Name  string `json:"name" yaml:"name"`
+	Email string `json:"email,omitempty" yaml:"email,omitempty"`
+	URL   string `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+type DependencyModel struct {
+	Name          string             `json:"name" yaml:"name"`
+	Alias         string             `json:"alias,omitempty" yaml:"alias,omitempty"`
+	Version       string             `json:"version" yaml:"version"`
+	Repository    string             `json:"repository,omitempty" yaml:"repository,omitempty"`
+	LockedVersion string             `json:"lockedVersion,omitempty" yaml:"lockedVersion,omitempty"`
+	Enabled       bool               `json:"enabled" yaml:"enabled"`
+	Conditions    []string           `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Tags          []string           `json:"tags,omitempty" yaml:"tags,omitempty"`
+	ImportValues  []ImportValueModel `json:"importValues,omitempty" yaml:"importValues,omitempty"`
+}
+
+// ImportValueModel imports the values of a dependency at the child key into its parent chart at the parent key, which
+// is empty for the root of the parent's values.
+type ImportValueModel struct {
+	Child  string `json:"child" yaml:"child"`
+	Parent string `json:"parent" yaml:"parent"`
+}
+
+// ValueModel is a row of the values table. Default and Description are the values rendered in the table, i.e. those
+// given with @default and description comments where present, and otherwise the ones parsed from the values file. Default
+// values parsed from the values file are exported as they are, those given with @default comments as their text.
+type ValueModel struct {
+	Key                string      `json:"key" yaml:"key"`
+	Type               string      `json:"type" yaml:"type"`
+	NotationType       string      `json:"notationType,omitempty" yaml:"notationType,omitempty"`
+	Default            interface{} `json:"default" yaml:"default"`
+	DefaultFrom        string      `json:"defaultFrom,omitempty" yaml:"defaultFrom,omitempty"`
+	Description        string      `json:"description" yaml:"description"`
+	Section            string      `json:"section,omitempty" yaml:"section,omitempty"`
+	Deprecated         bool        `json:"deprecated" yaml:"deprecated"`
+	DeprecationMessage string      `json:"deprecationMessage,omitempty" yaml:"deprecationMessage,omitempty"`
+	Dependency         string      `json:"dependency,omitempty" yaml:"dependency,omitempty"`
+	Global             bool        `json:"global" yaml:"global"`
+	LineNumber         int         `json:"lineNumber" yaml:"lineNumber"`
+	ParentKey          string      `json:"parentKey,omitempty" yaml:"parentKey,omitempty"`
+	Depth              int         `json:"depth" yaml:"depth"`
+	Anchor             string      `json:"anchor" yaml:"anchor"`
+	See                []string    `json:"see,omitempty" yaml:"see,omitempty"`
+	UsedIn             []string    `json:"usedIn,omitempty" yaml:"usedIn,omitempty"`
+	Required           bool        `json:"required" yaml:"required"`
+	RequiredMessage    string      `json:"requiredMessage,omitempty" yaml:"requiredMessage,omitempty"`
+}
+
+// SectionModel lists the keys of the values in a @section, the values without a section are listed last.
+type SectionModel struct {
+	Name    string   `json:"name" yaml:"name"`
+	Default bool     `json:"default" yaml:"default"`
+	Keys    []string `json:"keys" yaml:"keys"`
+}
+
+// CustomResourceDefinitionModel is a CRD from the crds directory of the chart, with the YAML of the examples of its
+// custom resource from the artifacthub.io/crdsExamples annotation.
+type CustomResourceDefinitionModel struct {
+	File     string                                 `json:"file" yaml:"file"`
+	Name     string                                 `json:"name" yaml:"name"`
+	Group    string                                 `json:"group" yaml:"group"`
+	Kind     string                                 `json:"kind" yaml:"kind"`
+	Plural   string                                 `json:"plural" yaml:"plural"`
+	Scope    string                                 `json:"scope" yaml:"scope"`
+	Versions []CustomResourceDefinitionVersionModel `json:"versions" yaml:"versions"`
+	Examples []string                               `json:"examples,omitempty" yaml:"examples,omitempty"`
+}
+
+type CustomResourceDefinitionVersionModel struct {
+	Name               string                        `json:"name" yaml:"name"`
+	Served             bool                          `json:"served" yaml:"served"`
+	Storage            bool                          `json:"storage" yaml:"storage"`
+	Deprecated         bool                          `json:"deprecated" yaml:"deprecated"`
+	DeprecationWarning string                        `json:"deprecationWarning,omitempty" yaml:"deprecationWarning,omitempty"`
+	Properties         []CustomResourcePropertyModel `json:"properties" yaml:"properties"`
+}
+
+type CustomResourcePropertyModel struct {
+	Path        string `json:"path" yaml:"path"`
+	Type        string `json:"type" yaml:"type"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Required    bool   `json:"required" yaml:"required"`
+}
+
+type NamedTemplateModel struct {
+	Name        string                        `json:"name" yaml:"name"`
+	File        string                        `json:"file" yaml:"file"`
+	Line        int                           `json:"line" yaml:"line"`
+	Description string                        `json:"description,omitempty" yaml:"description,omitempty"`
+	Parameters  []NamedTemplateParameterModel `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Example     string                        `json:"example,omitempty" yaml:"example,omitempty"`
+}
+
+type NamedTemplateParameterModel struct {
+	Name        string `json:"name" yaml:"name"`
+	Type        string `json:"type,omitempty" yaml:"type,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// RenderedResourceModel is a resource the chart creates with its default values, named after the release release-name.
+type RenderedResourceModel struct {
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string `json:"kind" yaml:"kind"`
+	Name       string `json:"name" yaml:"name"`
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Namespaced bool   `json:"namespaced" yaml:"namespaced"`
+	Hook       string `json:"hook,omitempty" yaml:"hook,omitempty"`
+	File       string `json:"file" yaml:"file"`
+}
+
+// RBACModel lists the roles and bindings among the rendered resources of the chart.
+type RBACModel struct {
+	Roles    []RBACRoleModel    `json:"roles" yaml:"roles"`
+	Bindings []RBACBindingModel `json:"bindings" yaml:"bindings"`
+}
+
+type RBACRoleModel struct {
+	Kind    string          `json:"kind" yaml:"kind"`
+	Name    string          `json:"name" yaml:"name"`
+	Rules   []RBACRuleModel `json:"rules" yaml:"rules"`
+	File    string          `json:"file" yaml:"file"`
+	Toggles []string        `json:"toggles,omitempty" yaml:"toggles,omitempty"`
+}
+
+type RBACRuleModel struct {
+	APIGroups       []string `json:"apiGroups,omitempty" yaml:"apiGroups,omitempty"`
+	Resources       []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	ResourceNames   []string `json:"resourceNames,omitempty" yaml:"resourceNames,omitempty"`
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty" yaml:"nonResourceURLs,omitempty"`
+	Verbs           []string `json:"verbs" yaml:"verbs"`
+}
+
+type RBACBindingModel struct {
+	Kind     string             `json:"kind" yaml:"kind"`
+	Name     string             `json:"name" yaml:"name"`
+	RoleRef  RBACRoleRefModel   `json:"roleRef" yaml:"roleRef"`
+	Subjects []RBACSubjectModel `json:"subjects" yaml:"subjects"`
+	File     string             `json:"file" yaml:"file"`
+	Toggles  []string           `json:"toggles,omitempty" yaml:"toggles,omitempty"`
+}
+
+type RBACRoleRefModel struct {
+	APIGroup string `json:"apiGroup,omitempty" yaml:"apiGroup,omitempty"`
+	Kind     string `json:"kind" yaml:"kind"`
+	Name     string `json:"name" yaml:"name"`
+}
+
+type RBACSubjectModel struct {
+	Kind      string `json:"kind" yaml:"kind"`
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+}
+
+// KubernetesCompatibilityModel tells whether the kubeVersion of the chart includes a Kubernetes minor version, and
+// which of the API versions the chart uses it removes or deprecates.
+type KubernetesCompatibilityModel struct {
+	Version        string                     `json:"version" yaml:"version"`
+	Supported      bool                       `json:"supported" yaml:"supported"`
+	Partial        bool                       `json:"partial" yaml:"partial"`
+	RemovedAPIs    []KubernetesAPIChangeModel `json:"removedAPIs,omitempty" yaml:"removedAPIs,omitempty"`
+	DeprecatedAPIs []KubernetesAPIChangeModel `json:"deprecatedAPIs,omitempty" yaml:"deprecatedAPIs,omitempty"`
+}
+
+type KubernetesAPIChangeModel struct {
+	APIVersion  string `json:"apiVersion" yaml:"apiVersion"`
+	Kind        string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	File        string `json:"file" yaml:"file"`
+	Line        int    `json:"line" yaml:"line"`
+	Replacement string `json:"replacement,omitempty" yaml:"replacement,omitempty"`
+}
+
+// ArtifactHubModel is the metadata of the chart from its Artifact Hub annotations.
+type ArtifactHubModel struct {
+	Changes []ArtifactHubChangeModel `json:"changes,omitempty" yaml:"changes,omitempty"`
+	Images  []ArtifactHubImageModel  `json:"images,omitempty" yaml:"images,omitempty"`
+	Links   []LinkModel              `json:"links,omitempty" yaml:"links,omitempty"`
+}
+
+type ArtifactHubChangeModel struct {
+	Kind        string      `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Description string      `json:"description" yaml:"description"`
+	Links       []LinkModel `json:"links,omitempty" yaml:"links,omitempty"`
+}
+
+type ArtifactHubImageModel struct {
+	Name        string   `json:"name" yaml:"name"`
+	Image       string   `json:"image" yaml:"image"`
+	Whitelisted bool     `json:"whitelisted" yaml:"whitelisted"`
+	Platforms   []string `json:"platforms,omitempty" yaml:"platforms,omitempty"`
+}
+
+type LinkModel struct {
+	Name string `json:"name" yaml:"name"`
+	URL  string `json:"url" yaml:"url"`
+}
+
+// GetOutputFormat returns the configured output format, markdown unless set otherwise.
+func GetOutputFormat() string {
+	outputFormat := viper.GetString("output-format")
+	if outputFormat == "" {
+		return MarkdownOutputFormat
+	}
+
+	return outputFormat
+}
+
+// IsModelOutputFormat reports whether the configured output format exports the documentation model rather than rendering
+// templates.
+func IsModelOutputFormat() bool {
+	outputFormat := GetOutputFormat()
+	return outputFormat == JSONOutputFormat || outputFormat == YAMLOutputFormat
+}
+
+// GetDocumentationModel builds the exported documentation model of a chart from the same data the templates are
+// rendered with.
+func GetDocumentationModel(info helm.ChartDocumentationInfo, helmDocsVersion string, dependencyValues []DependencyValues) (ChartDocumentationModel, error) {
+	return getDocumentationModel(info, helmDocsVersion, dependencyValues, "")
+}
+
+// GetSiteDocumentationModel builds the documentation model of a chart rendered on the documentation site, whose value
+// descriptions are HTML linking the values they refer to.
+func GetSiteDocumentationModel(info helm.ChartDocumentationInfo, helmDocsVersion string, dependencyValues []DependencyValues) (ChartDocumentationModel, error) {
+	return getDocumentationModel(info, helmDocsVersion, dependencyValues, siteOutputFormat)
+}
+
+// getDocumentationModel builds the documentation model of a chart, linking the references between values in the given
+// format. References are left as they are if no format is given.
+func getDocumentationModel(info helm.ChartDocumentationInfo, helmDocsVersion string, dependencyValues []DependencyValues, referenceFormat string) (ChartDocumentationModel, error) {
+	templateData, err := getChartTemplateData(info, helmDocsVersion, dependencyValues, false, &translator{})
+	if err != nil {
+		return ChartDocumentationModel{}, err
+	}
+
+	if referenceFormat != "" {
+		linkValueReferences(&templateData, referenceFormat)
+	}
+
+	model := ChartDocumentationModel{
+		Directory: info.ChartDirectory,
+		Chart: ChartModel{
+			Name:        info.Name,
+			Version:     info.Version,
+			AppVersion:  info.AppVersion,
+			ApiVersion:  info.ApiVersion,
+			KubeVersion: info.KubeVersion,
+			Type:        info.Type,
+			Description: info.Description,
+			Home:        info.Home,
+			Deprecated:  info.Deprecated,
+			Sources:     append([]string{}, info.Sources...),
+			Maintainers: make([]MaintainerModel, 0, len(info.Maintainers)),
+			Icon:        info.Icon,
+			Keywords:    append([]string{}, info.Keywords...),
+			Annotations: info.Annotations,
+		},
+		Dependencies: make([]DependencyModel, 0, len(info.Dependencies)),
+		Values:       make([]ValueModel, 0, len(templateData.Values)),
+		Sections:     make([]SectionModel, 0, len(templateData.Sections.Sections)+1),
+	}
+
+	for _, maintainer := range info.Maintainers {
+		model.Chart.Maintainers = append(model.Chart.Maintainers, MaintainerModel{Name: maintainer.Name, Email: maintainer.Email, URL: maintainer.Url})
+	}
+
+	for _, dependency := range templateData.Requirements.Dependencies {
+		dependencyModel := DependencyModel{
+			Name:          dependency.Name,
+			Alias:         dependency.Alias,
+			Version:       dependency.Version,
+			Repository:    dependency.Repository,
+			LockedVersion: dependency.LockedVersion,
+			Enabled:       dependency.EnabledByDefault,
+			Conditions:    dependency.GetConditions(),
+			Tags:          dependency.Tags,
+		}
+
+		if len(dependencyModel.Conditions) == 0 {
+			dependencyModel.Conditions = nil
+		}
+
+		for _, importValue := range dependency.Imports {
+			dependencyModel.ImportValues = append(dependencyModel.ImportValues, ImportValueModel{Child: importValue.Child, Parent: importValue.Parent})
+		}
+
+		model.Dependencies = append(model.Dependencies, dependencyModel)
+	}
+
+	for _, row := range templateData.Values {
+		model.Values = append(model.Values, getValueModel(row))
+	}
+
+	for _, s := range templateData.Sections.Sections {
+		model.Sections = append(model.Sections, getSectionModel(s, false))
+	}
+
+	if len(templateData.Sections.DefaultSection.SectionItems) > 0 {
+		model.Sections = append(model.Sections, getSectionModel(templateData.Sections.DefaultSection, true))
+	}
+
+	for _, crd := range info.CustomResourceDefinitions {
+		model.CustomResourceDefinitions = append(model.CustomResourceDefinitions, getCustomResourceDefinitionModel(crd))
+	}
+
+	for _, namedTemplate := range info.NamedTemplates {
+		model.NamedTemplates = append(model.NamedTemplates, getNamedTemplateModel(namedTemplate))
+	}
+
+	for _, resource := range info.RenderedResources {
+		model.RenderedResources = append(model.RenderedResources, RenderedResourceModel(resource))
+	}
+
+	if len(info.RBAC.Roles) > 0 || len(info.RBAC.Bindings) > 0 {
+		model.RBAC = getRBACModel(info.RBAC)
+	}
+
+	for _, compatibility := range info.KubernetesCompatibility {
+		model.KubernetesCompatibility = append(model.KubernetesCompatibility, KubernetesCompatibilityModel{
+			Version:        compatibility.Version,
+			Supported:      compatibility.Supported,
+			Partial:        compatibility.Partial,
+			RemovedAPIs:    getKubernetesAPIChangeModels(compatibility.RemovedAPIs),
+			DeprecatedAPIs: getKubernetesAPIChangeModels(compatibility.DeprecatedAPIs),
+		})
+	}
+
+	artifactHub := info.ArtifactHub
+	if len(artifactHub.Changes) > 0 || len(artifactHub.Images) > 0 || len(artifactHub.Links) > 0 {
+		model.ArtifactHub = getArtifactHubModel(artifactHub)
+	}
+
+	return model, nil
+}
+
+func getCustomResourceDefinitionModel(crd helm.CustomResourceDefinition) CustomResourceDefinitionModel {
+	model := CustomResourceDefinitionModel{
+		File:     crd.File,
+		Name:     crd.Name,
+		Group:    crd.Group,
+		Kind:     crd.Kind,
+		Plural:   crd.Plural,
+		Scope:    crd.Scope,
+		Versions: make([]CustomResourceDefinitionVersionModel, 0, len(crd.Versions)),
+	}
+
+	for _, version := range crd.Versions {
+		properties := make([]CustomResourcePropertyModel, 0, len(version.Properties))
+		for _, property := range version.Properties {
+			properties = append(properties, CustomResourcePropertyModel(property))
+		}
+
+		model.Versions = append(model.Versions, CustomResourceDefinitionVersionModel{
+			Name:               version.Name,
+			Served:             version.Served,
+			Storage:            version.Storage,
+			Deprecated:         version.Deprecated,
+			DeprecationWarning: version.DeprecationWarning,
+			Properties:         properties,
+		})
+	}
+
+	for _, example := range crd.Examples {
+		model.Examples = append(model.Examples, example.Yaml)
+	}
+
+	return model
+}
+
+func getNamedTemplateModel(namedTemplate helm.NamedTemplate) NamedTemplateModel {
+	model := NamedTemplateModel{
+		Name:        namedTemplate.Name,
+		File:        namedTemplate.File,
+		Line:        namedTemplate.Line,
+		Description: namedTemplate.Description,
+		Example:     namedTemplate.Example,
+	}
+
+	for _, parameter := range namedTemplate.Parameters {
+		model.Parameters = append(model.Parameters, NamedTemplateParameterModel(parameter))
+	}
+
+	return model
+}
+
+func getRBACModel(rbac helm.ChartRBAC) *RBACModel {
+	model := &RBACModel{
+		Roles:    make([]RBACRoleModel, 0, len(rbac.Roles)),
+		Bindings: make([]RBACBindingModel, 0, len(rbac.Bindings)),
+	}
+
+	for _, role := range rbac.Roles {
+		rules := make([]RBACRuleModel, 0, len(role.Rules))
+		for _, rule := range role.Rules {
+			rules = append(rules, RBACRuleModel(rule))
+		}
+
+		model.Roles = append(model.Roles, RBACRoleModel{Kind: role.Kind, Name: role.Name, Rules: rules, File: role.File, Toggles: role.Toggles})
+	}
+
+	for _, binding := range rbac.Bindings {
+		subjects := make([]RBACSubjectModel, 0, len(binding.Subjects))
+		for _, subject := range binding.Subjects {
+			subjects = append(subjects, RBACSubjectModel(subject))
+		}
+
+		model.Bindings = append(model.Bindings, RBACBindingModel{
+			Kind:     binding.Kind,
+			Name:     binding.Name,
+			RoleRef:  RBACRoleRefModel(binding.RoleRef),
+			Subjects: subjects,
+			File:     binding.File,
+			Toggles:  binding.Toggles,
+		})
+	}
+
+	return model
+}
+
+func getKubernetesAPIChangeModels(changes []helm.KubernetesAPIChange) []KubernetesAPIChangeModel {
+	var models []KubernetesAPIChangeModel
+	for _, change := range changes {
+		models = append(models, KubernetesAPIChangeModel{
+			APIVersion:  change.APIVersion,
+			Kind:        change.Kind,
+			File:        change.File,
+			Line:        change.Line,
+			Replacement: change.Replacement,
+		})
+	}
+
+	return models
+}
+
+func getArtifactHubModel(artifactHub helm.ArtifactHubAnnotations) *ArtifactHubModel {
+	model := &ArtifactHubModel{Links: getLinkModels(artifactHub.Links)}
+
+	for _, change := range artifactHub.Changes {
+		model.Changes = append(model.Changes, ArtifactHubChangeModel{Kind: change.Kind, Description: change.Description, Links: getLinkModels(change.Links)})
+	}
+
+	for _, image := range artifactHub.Images {
+		model.Images = append(model.Images, ArtifactHubImageModel(image))
+	}
+
+	return model
+}
+
+func getLinkModels(links []helm.ArtifactHubLink) []LinkModel {
+	var models []LinkModel
+	for _, link := range links {
+		models = append(models, LinkModel(link))
+	}
+
+	return models
+}
+
+func getValueModel(row valueRow) ValueModel {
+	defaultValue := row.Default
+	if defaultValue == "" {
+		defaultValue = row.AutoDefault
+	}
+
+	description := row.Description
+	if description == "" {
+		description = row.AutoDescription
+	}
+
+	var see []string
+	for _, reference := range row.See {
+		see = append(see, reference.Key)
+	}
+
+	return ValueModel{
+		Key:                row.Key,
+		Type:               row.Type,
+		NotationType:       row.NotationType,
+		Default:            getDefaultValueModel(defaultValue),
+		DefaultFrom:        row.DefaultFrom,
+		Description:        description,
+		Section:            row.Section,
+		Deprecated:         row.Deprecated,
+		DeprecationMessage: row.DeprecationMessage,
+		Dependency:         row.Dependency,
+		Global:             row.IsGlobal,
+		LineNumber:         row.LineNumber,
+		ParentKey:          row.ParentKey,
+		Depth:              row.Depth,
+		Anchor:             getValueAnchor(row.Key),
+		See:                see,
+		UsedIn:             row.UsedIn,
+		Required:           row.Required,
+		RequiredMessage:    row.RequiredMessage,
+	}
+}
+
+// getDefaultValueModel decodes a default value rendered in the values table. Values parsed from the values file are
+// rendered as JSON in a code span, any other default is the text of a @default comment.
+func getDefaultValueModel(defaultValue string) interface{} {
+	if defaultValue == "`nil`" {
+		return nil
+	}
+
+	if len(defaultValue) > 1 && strings.HasPrefix(defaultValue, "`") && strings.HasSuffix(defaultValue, "`") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(defaultValue[1:len(defaultValue)-1]), &decoded); err == nil {
+			return decoded
+		}
+
+		return defaultValue[1 : len(defaultValue)-1]
+	}
+
+	return defaultValue
+}
+
+func getSectionModel(s section, isDefault bool) SectionModel {
+	keys := make([]string, 0, len(s.SectionItems))
+	for _, row := range s.SectionItems {
+		keys = append(keys, row.Key)
+	}
+
+	return SectionModel{Name: s.SectionName, Default: isDefault, Keys: keys}
+}
+
+// MarshalDocumentationModel serialises a documentation model in the given output format.
+func MarshalDocumentationModel(model interface{}, outputFormat string) ([]byte, error) {
+	var output bytes.Buffer
+
+	switch outputFormat {
+	case JSONOutputFormat:
+		encoder := json.NewEncoder(&output)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(model); err != nil {
+			return nil, err
+		}
+	case YAMLOutputFormat:
+		encoder := yaml.NewEncoder(&output)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(model); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported documentation model output format %q", outputFormat)
+	}
+
+	return output.Bytes(), nil
+}
+
+// getModelOutputFilePath returns the output file with its extension replaced to match the output format, e.g.
+// README.json for README.md.
+func getModelOutputFilePath(chartDirectory string, outputFormat string) string {
+	outputFile := viper.GetString("output-file")
+	return filepath.Join(chartDirectory, strings.TrimSuffix(outputFile, filepath.Ext(outputFile))+"."+outputFormat)
+}
+
+// PrintDocumentationModel exports the documentation model of a single chart next to its documentation.
+func PrintDocumentationModel(info helm.ChartDocumentationInfo, dryRun bool, helmDocsVersion string, dependencyValues []DependencyValues) {
+	outputFormat := GetOutputFormat()
+	log.Infof("Exporting %s documentation model for chart %s", outputFormat, info.ChartDirectory)
+
+	model, err := GetDocumentationModel(info, helmDocsVersion, dependencyValues)
+	if err != nil {
+		log.Warnf("Error generating documentation model for chart %s: %s", info.ChartDirectory, err)
+		return
+	}
+
+	model.SchemaVersion = DocumentationModelSchemaVersion
+	model.HelmDocsVersion = helmDocsVersion
+
+	output, err := MarshalDocumentationModel(model, outputFormat)
+	if err != nil {
+		log.Warnf("Error serialising documentation model for chart %s: %s", info.ChartDirectory, err)
+		return
+	}
+
+	if dryRun {
+		_, _ = os.Stdout.Write(output)
+		return
+	}
+
+	if err := os.WriteFile(getModelOutputFilePath(info.ChartDirectory, outputFormat), output, 0o644); err != nil {
+		log.Warnf("Error writing documentation model file for chart %s: %s", info.ChartDirectory, err)
+	}
+}
@@ -0,0 +1,168 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func getExportTestChartInfo(t *testing.T) helm.ChartDocumentationInfo {
+	helmValues := parseYamlValues(`
+# -- The image
+image: nginx
+
+# -- Number of replicas
+# @section -- Scaling
+replicas: 1
+	`)
+
+	return helm.ChartDocumentationInfo{
+		ChartDirectory: t.TempDir(),
+		ChartMeta: helm.ChartMeta{
+			ApiVersion:  "v2",
+			Name:        "my-chart",
+			Version:     "1.0.0",
+			Maintainers: []helm.ChartMetaMaintainer{{Name: "John Doe", Email: "john@example.com"}},
+		},
+		ChartRequirements: helm.ChartRequirements{
+			Dependencies: []helm.ChartRequirementsItem{{Name: "redis", Version: "17.0.0", Repository: "https://charts.example.com"}},
+		},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+	}
+}
+
+func TestGetDocumentationModel(t *testing.T) {
+	model, err := GetDocumentationModel(getExportTestChartInfo(t), "", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-chart", model.Chart.Name)
+	assert.Equal(t, []MaintainerModel{{Name: "John Doe", Email: "john@example.com"}}, model.Chart.Maintainers)
+	assert.Equal(t, []DependencyModel{{Name: "redis", Version: "17.0.0", Repository: "https://charts.example.com", Enabled: true}}, model.Dependencies)
+
+	assert.Equal(t, []ValueModel{
+		{Key: "image", Type: "string", Default: "nginx", Description: "The image", LineNumber: 2, Anchor: "value-image"},
+		{Key: "replicas", Type: "int", Default: float64(1), Description: "Number of replicas", Section: "Scaling", LineNumber: 6, Anchor: "value-replicas"},
+	}, model.Values)
+
+	assert.Equal(t, []SectionModel{
+		{Name: "Scaling", Keys: []string{"replicas"}},
+		{Name: "Other Values", Default: true, Keys: []string{"image"}},
+	}, model.Sections)
+}
+
+func TestGetDocumentationModelChartDetails(t *testing.T) {
+	info := getExportTestChartInfo(t)
+	info.CustomResourceDefinitions = []helm.CustomResourceDefinition{{
+		File:     "crds/widgets.yaml",
+		Name:     "widgets.example.com",
+		Group:    "example.com",
+		Kind:     "Widget",
+		Plural:   "widgets",
+		Scope:    "Namespaced",
+		Versions: []helm.CustomResourceDefinitionVersion{{Name: "v1", Served: true, Storage: true, Properties: []helm.CustomResourceProperty{{Path: "spec.size", Type: "integer", Required: true}}}},
+		Examples: []helm.ArtifactHubCRDExample{{Yaml: "kind: Widget\n"}},
+	}}
+	info.NamedTemplates = []helm.NamedTemplate{{Name: "lib.name", File: "templates/_helpers.tpl", Line: 2, Parameters: []helm.NamedTemplateParameter{{Name: "context"}}}}
+	info.RenderedResources = []helm.RenderedResource{{APIVersion: "apps/v1", Kind: "Deployment", Name: "release-name-app", Namespaced: true, File: "templates/deployment.yaml"}}
+	info.RBAC = helm.ChartRBAC{
+		Roles:    []helm.RBACRole{{Kind: "Role", Name: "release-name", Rules: []helm.RBACRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}}, File: "templates/rbac.yaml", Toggles: []string{"rbac.create"}}},
+		Bindings: []helm.RBACBinding{{Kind: "RoleBinding", Name: "release-name", RoleRef: helm.RBACRoleRef{Kind: "Role", Name: "release-name"}, Subjects: []helm.RBACSubject{{Kind: "ServiceAccount", Name: "release-name"}}, File: "templates/rbac.yaml"}},
+	}
+	info.KubernetesCompatibility = []helm.KubernetesVersionCompatibility{{
+		Version:     "1.25",
+		Supported:   true,
+		RemovedAPIs: []helm.KubernetesAPIChange{{KubernetesAPIUsage: helm.KubernetesAPIUsage{APIVersion: "batch/v1beta1", Kind: "CronJob", File: "templates/cronjob.yaml", Line: 1}, Replacement: "batch/v1"}},
+	}}
+	info.ArtifactHub = helm.ArtifactHubAnnotations{
+		Changes: []helm.ArtifactHubChange{{Kind: "fixed", Description: "Fix the service port"}},
+		Images:  []helm.ArtifactHubImage{{Name: "app", Image: "example.com/app:1.0.0"}},
+		Links:   []helm.ArtifactHubLink{{Name: "Support", URL: "https://example.com/support"}},
+	}
+
+	model, err := GetDocumentationModel(info, "", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []CustomResourceDefinitionModel{{
+		File:     "crds/widgets.yaml",
+		Name:     "widgets.example.com",
+		Group:    "example.com",
+		Kind:     "Widget",
+		Plural:   "widgets",
+		Scope:    "Namespaced",
+		Versions: []CustomResourceDefinitionVersionModel{{Name: "v1", Served: true, Storage: true, Properties: []CustomResourcePropertyModel{{Path: "spec.size", Type: "integer", Required: true}}}},
+		Examples: []string{"kind: Widget\n"},
+	}}, model.CustomResourceDefinitions)
+	assert.Equal(t, []NamedTemplateModel{{Name: "lib.name", File: "templates/_helpers.tpl", Line: 2, Parameters: []NamedTemplateParameterModel{{Name: "context"}}}}, model.NamedTemplates)
+	assert.Equal(t, []RenderedResourceModel{{APIVersion: "apps/v1", Kind: "Deployment", Name: "release-name-app", Namespaced: true, File: "templates/deployment.yaml"}}, model.RenderedResources)
+	assert.Equal(t, &RBACModel{
+		Roles:    []RBACRoleModel{{Kind: "Role", Name: "release-name", Rules: []RBACRuleModel{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}}, File: "templates/rbac.yaml", Toggles: []string{"rbac.create"}}},
+		Bindings: []RBACBindingModel{{Kind: "RoleBinding", Name: "release-name", RoleRef: RBACRoleRefModel{Kind: "Role", Name: "release-name"}, Subjects: []RBACSubjectModel{{Kind: "ServiceAccount", Name: "release-name"}}, File: "templates/rbac.yaml"}},
+	}, model.RBAC)
+	assert.Equal(t, []KubernetesCompatibilityModel{{
+		Version:     "1.25",
+		Supported:   true,
+		RemovedAPIs: []KubernetesAPIChangeModel{{APIVersion: "batch/v1beta1", Kind: "CronJob", File: "templates/cronjob.yaml", Line: 1, Replacement: "batch/v1"}},
+	}}, model.KubernetesCompatibility)
+	assert.Equal(t, &ArtifactHubModel{
+		Changes: []ArtifactHubChangeModel{{Kind: "fixed", Description: "Fix the service port"}},
+		Images:  []ArtifactHubImageModel{{Name: "app", Image: "example.com/app:1.0.0"}},
+		Links:   []LinkModel{{Name: "Support", URL: "https://example.com/support"}},
+	}, model.ArtifactHub)
+
+	output, err := MarshalDocumentationModel(model, JSONOutputFormat)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"customResourceDefinitions": [`)
+	assert.Contains(t, string(output), `"removedAPIs": [`)
+}
+
+func TestGetDocumentationModelOmitsMissingChartDetails(t *testing.T) {
+	model, err := GetDocumentationModel(getExportTestChartInfo(t), "", nil)
+	require.NoError(t, err)
+
+	output, err := MarshalDocumentationModel(model, JSONOutputFormat)
+	require.NoError(t, err)
+	for _, field := range []string{"customResourceDefinitions", "namedTemplates", "renderedResources", "rbac", "kubernetesCompatibility", "artifactHub"} {
+		assert.NotContains(t, string(output), `"`+field+`"`)
+	}
+}
+
+func TestGetDefaultValueModel(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{"enabled": true, "ports": []interface{}{float64(80)}}, getDefaultValueModel("`{\"enabled\":true,\"ports\":[80]}`"))
+	assert.Equal(t, "nginx", getDefaultValueModel("`\"nginx\"`"))
+	assert.Nil(t, getDefaultValueModel("`nil`"))
+	assert.Equal(t, "the chart's appVersion", getDefaultValueModel("the chart's appVersion"))
+	assert.Equal(t, "{{ .Release.Name }}", getDefaultValueModel("`{{ .Release.Name }}`"))
+}
+
+func TestMarshalDocumentationModel(t *testing.T) {
+	model := RepositoryDocumentationModel{
+		SchemaVersion: DocumentationModelSchemaVersion,
+		Charts: []ChartDocumentationModel{{
+			Directory: "charts/my-chart",
+			Chart:     ChartModel{Name: "my-chart", Version: "1.0.0", ApiVersion: "v2"},
+			Values:    []ValueModel{{Key: "image", Type: "string", Default: "nginx", LineNumber: 2}},
+		}},
+	}
+
+	output, err := MarshalDocumentationModel(model, JSONOutputFormat)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"schemaVersion": "helm-docs/v1"`)
+	assert.Contains(t, string(output), `"default": "nginx"`)
+
+	output, err = MarshalDocumentationModel(model, YAMLOutputFormat)
+	require.NoError(t, err)
+
+	var parsed RepositoryDocumentationModel
+	require.NoError(t, yaml.Unmarshal(output, &parsed))
+	assert.Equal(t, DocumentationModelSchemaVersion, parsed.SchemaVersion)
+	assert.Equal(t, "my-chart", parsed.Charts[0].Chart.Name)
+	assert.Equal(t, model.Charts[0].Values, parsed.Charts[0].Values)
+
+	_, err = MarshalDocumentationModel(model, "toml")
+	assert.Error(t, err)
+}
@@ -2,22 +2,27 @@ package document
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 
 	"github.com/norwoodj/helm-docs/pkg/helm"
+	"github.com/norwoodj/helm-docs/pkg/util"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
-func getOutputFile(chartDirectory string, dryRun bool) (*os.File, error) {
+func getOutputFilePath(chartDirectory string, language string) string {
+	return filepath.Join(chartDirectory, util.LanguageFilePath(viper.GetString("output-file"), language))
+}
+
+func getOutputFile(chartDirectory string, dryRun bool, language string) (*os.File, error) {
 	if dryRun {
 		return os.Stdout, nil
 	}
 
-	outputFile := viper.GetString("output-file")
-	f, err := os.Create(filepath.Join(chartDirectory, outputFile))
+	f, err := os.Create(getOutputFilePath(chartDirectory, language))
 
 	if err != nil {
 		return nil, err
@@ -26,48 +31,102 @@ func getOutputFile(chartDirectory string, dryRun bool) (*os.File, error) {
 	return f, err
 }
 
-func PrintDocumentation(chartDocumentationInfo helm.ChartDocumentationInfo, chartSearchRoot string, templateFiles []string, dryRun bool, helmDocsVersion string, badgeStyle string, dependencyValues []DependencyValues, skipVersionFooter bool) {
-	log.Infof("Generating README Documentation for chart %s", chartDocumentationInfo.ChartDirectory)
+// PrintDocumentation renders the documentation of a chart. An empty language renders the documentation in the default
+// language to the configured output file, any other language renders translated documentation to e.g. README.de.md.
+// Charts failing to render are reported as warnings, while refusing to overwrite a manually edited output file is
+// returned as an error.
+func PrintDocumentation(chartDocumentationInfo helm.ChartDocumentationInfo, chartSearchRoot string, templateFiles []string, dryRun bool, helmDocsVersion string, badgeStyle string, dependencyValues []DependencyValues, skipVersionFooter bool, language string) error {
+	if language == "" {
+		log.Infof("Generating README Documentation for chart %s", chartDocumentationInfo.ChartDirectory)
+	} else {
+		log.Infof("Generating %s README Documentation for chart %s", language, chartDocumentationInfo.ChartDirectory)
+	}
+
+	outputPath := getOutputFilePath(chartDocumentationInfo.ChartDirectory, language)
+	format := getDocumentFormat(outputPath)
+
+	t, err := newTranslator(chartDocumentationInfo.ChartDirectory, chartSearchRoot, language)
+	if err != nil {
+		log.Warnf("Error reading translations for chart %s: %s", chartDocumentationInfo.ChartDirectory, err)
+		return nil
+	}
 
 	chartDocumentationTemplate, err := newChartDocumentationTemplate(
 		chartDocumentationInfo,
 		chartSearchRoot,
 		templateFiles,
 		badgeStyle,
+		t,
+		format,
 	)
 
 	if err != nil {
 		log.Warnf("Error generating gotemplates for chart %s: %s", chartDocumentationInfo.ChartDirectory, err)
-		return
+		return nil
 	}
 
-	chartTemplateDataObject, err := getChartTemplateData(chartDocumentationInfo, helmDocsVersion, dependencyValues, skipVersionFooter)
+	chartTemplateDataObject, err := getChartTemplateData(chartDocumentationInfo, helmDocsVersion, dependencyValues, skipVersionFooter, t)
 	if err != nil {
 		log.Warnf("Error generating template data for chart %s: %s", chartDocumentationInfo.ChartDirectory, err)
-		return
+		return nil
+	}
+
+	linkValueReferences(&chartTemplateDataObject, format)
+
+	var output bytes.Buffer
+	if viper.GetBool("inject") {
+		existingOutput, err := os.ReadFile(outputPath)
+		if err != nil {
+			return fmt.Errorf("could not read chart README file %s to inject documentation into: %w", outputPath, err)
+		}
+
+		output, err = injectDocumentation(existingOutput, chartDocumentationTemplate, chartTemplateDataObject, format)
+		if err != nil {
+			return fmt.Errorf("could not inject documentation into chart README file %s: %w", outputPath, err)
+		}
+	} else {
+		err = chartDocumentationTemplate.Execute(&output, chartTemplateDataObject)
+		if err != nil {
+			log.Warnf("Error generating documentation for chart %s: %s", chartDocumentationInfo.ChartDirectory, err)
+		}
+
+		output = applyOutputFormat(output, format)
+
+		if viper.GetBool("detect-manual-edits") {
+			if !dryRun && !viper.GetBool("force") {
+				manualEdits, err := getManualEdits(outputPath, output.Bytes())
+				if err != nil {
+					log.Warnf("Error checking chart README file %s for manual edits, skipping chart: %s", outputPath, err)
+					return nil
+				}
+
+				if manualEdits != "" {
+					fmt.Fprint(os.Stderr, manualEdits)
+					return fmt.Errorf("chart README file %s was edited manually since it was generated, move the edits into the template, or use --force to overwrite them", outputPath)
+				}
+			}
+
+			output = appendContentHash(output, format)
+		}
 	}
 
-	outputFile, err := getOutputFile(chartDocumentationInfo.ChartDirectory, dryRun)
+	outputFile, err := getOutputFile(chartDocumentationInfo.ChartDirectory, dryRun, language)
 	if err != nil {
-		log.Warnf("Could not open chart README file %s, skipping chart", filepath.Join(chartDocumentationInfo.ChartDirectory, viper.GetString("output-file")))
-		return
+		log.Warnf("Could not open chart README file %s, skipping chart", outputPath)
+		return nil
 	}
 
 	if !dryRun {
 		defer outputFile.Close()
 	}
 
-	var output bytes.Buffer
-	err = chartDocumentationTemplate.Execute(&output, chartTemplateDataObject)
-	if err != nil {
-		log.Warnf("Error generating documentation for chart %s: %s", chartDocumentationInfo.ChartDirectory, err)
-	}
-
-	output = applyMarkDownFormat(output)
 	_, err = output.WriteTo(outputFile)
 	if err != nil {
 		log.Warnf("Error generating documentation file for chart %s: %s", chartDocumentationInfo.ChartDirectory, err)
 	}
+
+	t.reportMissingTranslations(chartDocumentationInfo.ChartDirectory)
+	return nil
 }
 
 func applyMarkDownFormat(output bytes.Buffer) bytes.Buffer {
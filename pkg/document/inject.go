@@ -0,0 +1,84 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// Markers delimiting the regions of a hand-written output file that helm-docs owns in inject mode, e.g.
+// <!-- helm-docs:start:values --> and <!-- helm-docs:end:values -->. AsciiDoc files use line comments instead, e.g.
+// // helm-docs:start:values
+var injectionMarkerRegex = regexp.MustCompile(`(?m)<!--\s*helm-docs:(start|end):([A-Za-z0-9_.-]+)\s*-->|^//[ \t]*helm-docs:(start|end):([A-Za-z0-9_.-]+)[ \t]*$`)
+
+// getInjectedTemplateName returns the named template rendered into the region with the given marker name. Short names
+// refer to the built-in sections, e.g. values renders chart.valuesSection, while names containing a dot are used as-is.
+func getInjectedTemplateName(markerName string) string {
+	if strings.Contains(markerName, ".") {
+		return markerName
+	}
+
+	return fmt.Sprintf("chart.%sSection", markerName)
+}
+
+// injectDocumentation replaces the contents of each region between helm-docs markers of an existing output file with
+// the rendered template named by the markers, leaving everything outside the regions untouched.
+func injectDocumentation(existingOutput []byte, documentationTemplate *template.Template, data chartTemplateData, format string) (bytes.Buffer, error) {
+	var output bytes.Buffer
+	markers := injectionMarkerRegex.FindAllSubmatchIndex(existingOutput, -1)
+
+	if len(markers) == 0 {
+		return output, fmt.Errorf("no <!-- helm-docs:start:name --> markers found")
+	}
+
+	openMarkerName := ""
+	copiedUntil := 0
+
+	for _, marker := range markers {
+		if marker[2] < 0 {
+			marker = []int{marker[0], marker[1], marker[6], marker[7], marker[8], marker[9]}
+		}
+
+		kind := string(existingOutput[marker[2]:marker[3]])
+		name := string(existingOutput[marker[4]:marker[5]])
+
+		if kind == "start" {
+			if openMarkerName != "" {
+				return output, fmt.Errorf("found start marker for %s before the end marker for %s", name, openMarkerName)
+			}
+
+			output.Write(existingOutput[copiedUntil:marker[1]])
+			openMarkerName = name
+			continue
+		}
+
+		if openMarkerName != name {
+			return output, fmt.Errorf("found end marker for %s without a matching start marker", name)
+		}
+
+		var section bytes.Buffer
+		if err := documentationTemplate.ExecuteTemplate(&section, getInjectedTemplateName(name), data); err != nil {
+			return output, err
+		}
+
+		section = applyOutputFormat(section, format)
+		output.WriteString("\n")
+		if rendered := strings.Trim(section.String(), "\n"); rendered != "" {
+			output.WriteString(rendered)
+			output.WriteString("\n")
+		}
+
+		output.Write(existingOutput[marker[0]:marker[1]])
+		copiedUntil = marker[1]
+		openMarkerName = ""
+	}
+
+	if openMarkerName != "" {
+		return output, fmt.Errorf("missing end marker for %s", openMarkerName)
+	}
+
+	output.Write(existingOutput[copiedUntil:])
+	return output, nil
+}
@@ -0,0 +1,60 @@
+package document
+
+import (
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func getInjectTestTemplate(t *testing.T) *template.Template {
+	tpl, err := template.New("test").Parse(`{{ define "chart.valuesSection" }}## Values
+
+| Key |
+|-----|
+{{- range .Values }}
+| {{ .Key }} |
+{{- end }}
+{{ end }}{{ define "custom.name" }}{{ .Name }}{{ end }}`)
+	require.NoError(t, err)
+
+	return tpl
+}
+
+func getInjectTestData() chartTemplateData {
+	return chartTemplateData{
+		ChartDocumentationInfo: helm.ChartDocumentationInfo{ChartMeta: helm.ChartMeta{Name: "my-chart"}},
+		Values:                 []valueRow{{Key: "image"}, {Key: "replicas"}},
+	}
+}
+
+func TestInjectDocumentation(t *testing.T) {
+	existing := "# Hand written  \n\n\n\nIntro <!-- helm-docs:start:custom.name -->old<!-- helm-docs:end:custom.name -->\n" +
+		"<!-- helm-docs:start:values -->\nstale table\n<!-- helm-docs:end:values -->\nOutro\n"
+
+	output, err := injectDocumentation([]byte(existing), getInjectTestTemplate(t), getInjectTestData(), MarkdownOutputFormat)
+	require.NoError(t, err)
+
+	assert.Equal(t, "# Hand written  \n\n\n\nIntro <!-- helm-docs:start:custom.name -->\nmy-chart\n<!-- helm-docs:end:custom.name -->\n"+
+		"<!-- helm-docs:start:values -->\n## Values\n\n| Key |\n|-----|\n| image |\n| replicas |\n<!-- helm-docs:end:values -->\nOutro\n", output.String())
+}
+
+func TestInjectDocumentationInvalidMarkers(t *testing.T) {
+	for name, existing := range map[string]string{
+		"no markers":         "# Hand written\n",
+		"missing end":        "<!-- helm-docs:start:values -->\n",
+		"missing start":      "<!-- helm-docs:end:values -->\n",
+		"mismatched names":   "<!-- helm-docs:start:values --><!-- helm-docs:end:requirements -->\n",
+		"nested":             "<!-- helm-docs:start:values --><!-- helm-docs:start:custom.name -->\n",
+		"unknown template":   "<!-- helm-docs:start:unknown --><!-- helm-docs:end:unknown -->\n",
+		"end before a start": "<!-- helm-docs:end:values --><!-- helm-docs:start:values -->\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := injectDocumentation([]byte(existing), getInjectTestTemplate(t), getInjectTestData(), MarkdownOutputFormat)
+			assert.Error(t, err)
+		})
+	}
+}
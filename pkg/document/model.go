@@ -3,7 +3,6 @@ package document
 import (
 	"fmt"
 	"sort"
-	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -13,27 +12,61 @@ import (
 )
 
 type valueRow struct {
-	Key             string
-	Type            string
-	NotationType    string
-	AutoDefault     string
-	Default         string
-	AutoDescription string
-	Description     string
-	Section         string
-	Column          int
-	LineNumber      int
-	Dependency      string
-	IsGlobal        bool
+	Key                string
+	Type               string
+	NotationType       string
+	AutoDefault        string
+	Default            string
+	DefaultFrom        string
+	AutoDescription    string
+	Description        string
+	Section            string
+	Deprecated         bool
+	DeprecationMessage string
+	Column             int
+	LineNumber         int
+	Dependency         string
+	IsGlobal           bool
+	Translations       map[string]string
+	ParentKey          string
+	ParentDescription  string
+	Depth              int
+	Anchor             string
+	See                []valueReference
+	Example            string
+
+	// Paths of the templates referencing the value, relative to the chart directory
+	UsedIn []string
+
+	// Whether the templates of the chart fail to render without the value, and the message they fail with
+	Required        bool
+	RequiredMessage string
+
+	// Descriptions with their [[other.key]] references linked as HTML, for the HTML values tables of markdown documents
+	HtmlAutoDescription string
+	HtmlDescription     string
 }
 
 type chartTemplateData struct {
 	helm.ChartDocumentationInfo
 	HelmDocsVersion   string
 	Values            []valueRow
+	ValuesTree        *valueTreeNode
+	ValuesToc         []valuesTocSection
+	Requirements      requirements
 	Sections          sections
 	Files             files
 	SkipVersionFooter bool
+	Language          string
+
+	// Whether the values tables have a column listing the templates each value is used in
+	ValuesUsedInColumn bool
+
+	// The values the templates of the chart fail to render without, sorted by key
+	RequiredValues []requiredValue
+
+	// Resolved [[other.key]] references of the value descriptions, keyed by the key they refer to
+	valueReferences map[string]valueReference
 }
 
 type sections struct {
@@ -43,6 +76,7 @@ type sections struct {
 
 type section struct {
 	SectionName  string
+	Anchor       string
 	SectionItems []valueRow
 }
 
@@ -81,7 +115,7 @@ func sortValueRowsByOrder(valueRows []valueRow, sortOrder string) {
 	})
 }
 
-func sortValueRows(valueRows []valueRow) {
+func getValuesSortOrder() string {
 	sortOrder := viper.GetString("sort-values-order")
 
 	if sortOrder != FileSortOrder && sortOrder != AlphaNumSortOrder {
@@ -89,23 +123,28 @@ func sortValueRows(valueRows []valueRow) {
 		sortOrder = AlphaNumSortOrder
 	}
 
-	sortValueRowsByOrder(valueRows, sortOrder)
+	return sortOrder
 }
 
-func sortSectionedValueRows(sectionedValueRows sections) {
-	sortOrder := viper.GetString("sort-values-order")
+func getSectionsSortOrder() string {
 	sortSectionsOrder := viper.GetString("sort-sections-order")
 
-	if sortOrder != FileSortOrder && sortOrder != AlphaNumSortOrder {
-		log.Warnf("Invalid sort order provided %s, defaulting to %s", sortOrder, AlphaNumSortOrder)
-		sortOrder = AlphaNumSortOrder
-	}
-
 	if sortSectionsOrder != FileSortOrder && sortSectionsOrder != AlphaNumSortOrder {
 		log.Warnf("Invalid sections sort order provided %s, defaulting to %s", sortSectionsOrder, AlphaNumSortOrder)
 		sortSectionsOrder = AlphaNumSortOrder
 	}
 
+	return sortSectionsOrder
+}
+
+func sortValueRows(valueRows []valueRow) {
+	sortValueRowsByOrder(valueRows, getValuesSortOrder())
+}
+
+func sortSectionedValueRows(sectionedValueRows sections) {
+	sortOrder := getValuesSortOrder()
+	sortSectionsOrder := getSectionsSortOrder()
+
 	if sortSectionsOrder == AlphaNumSortOrder {
 		sort.Slice(sectionedValueRows.Sections, func(i, j int) bool {
 			return sectionedValueRows.Sections[i].SectionName < sectionedValueRows.Sections[j].SectionName
@@ -169,50 +208,33 @@ func getSectionedValueRows(valueRows []valueRow) sections {
 	return valueRowsSectionSorted
 }
 
-func getChartTemplateData(info helm.ChartDocumentationInfo, helmDocsVersion string, dependencyValues []DependencyValues, skipVersionFooter bool) (chartTemplateData, error) {
+func getChartTemplateData(info helm.ChartDocumentationInfo, helmDocsVersion string, dependencyValues []DependencyValues, skipVersionFooter bool, t *translator) (chartTemplateData, error) {
 	valuesTableRows, err := getUnsortedValueRows(info.ChartValues, info.ChartValuesDescriptions)
 	if err != nil {
 		return chartTemplateData{}, err
 	}
 
+	t.localizeValueRows(valuesTableRows, info.ChartValuesTranslations[t.language])
+
 	if viper.GetBool("ignore-non-descriptions") {
 		valuesTableRows = removeRowsWithoutDescription(valuesTableRows)
 	}
 
 	if len(dependencyValues) > 0 {
-		seenGlobalKeys := make(map[string]bool)
-		for i, row := range valuesTableRows {
-			if strings.HasPrefix(row.Key, "global.") {
-				valuesTableRows[i].IsGlobal = true
-				seenGlobalKeys[row.Key] = true
-			}
-		}
-
-		for _, dep := range dependencyValues {
-			depValuesTableRows, err := getUnsortedValueRows(dep.ChartValues, dep.ChartValuesDescriptions)
-			if err != nil {
-				return chartTemplateData{}, err
-			}
-
-			for _, row := range depValuesTableRows {
-				if row.Key == "global" || strings.HasPrefix(row.Key, "global.") {
-					if seenGlobalKeys[row.Key] {
-						continue
-					}
-					row.IsGlobal = true
-					seenGlobalKeys[row.Key] = true
-				} else {
-					row.Key = dep.Prefix + "." + row.Key
-				}
-
-				row.Dependency = dep.Prefix
-				valuesTableRows = append(valuesTableRows, row)
-			}
+		valuesTableRows, err = getDependencyValueRows(info, valuesTableRows, dependencyValues, t)
+		if err != nil {
+			return chartTemplateData{}, err
 		}
 	}
 
+	setValueRowsUsages(valuesTableRows, info.ValueUsages)
+	setValueRowsRequired(valuesTableRows, info.RequiredValues)
+	valueReferences := setValueRowsReferences(info, valuesTableRows, t.language)
 	sortValueRows(valuesTableRows)
+	setValueRowsHierarchy(valuesTableRows)
 	valueRowsSectionSorted := getSectionedValueRows(valuesTableRows)
+	t.localizeSections(&valueRowsSectionSorted)
+	setSectionAnchors(&valueRowsSectionSorted)
 	sortSectionedValueRows(valueRowsSectionSorted)
 
 	files, err := getFiles(info.ChartDirectory)
@@ -224,9 +246,16 @@ func getChartTemplateData(info helm.ChartDocumentationInfo, helmDocsVersion stri
 		ChartDocumentationInfo: info,
 		HelmDocsVersion:        helmDocsVersion,
 		Values:                 valuesTableRows,
+		ValuesTree:             getValuesTree(valuesTableRows),
+		ValuesToc:              getValuesToc(valuesTableRows, valueRowsSectionSorted),
+		Requirements:           getRequirements(info, valuesTableRows, t.language),
 		Sections:               valueRowsSectionSorted,
 		Files:                  files,
 		SkipVersionFooter:      skipVersionFooter,
+		Language:               t.language,
+		ValuesUsedInColumn:     viper.GetBool("values-used-in-column"),
+		RequiredValues:         getRequiredValues(info, valuesTableRows),
+		valueReferences:        valueReferences,
 	}, nil
 }
 
@@ -0,0 +1,79 @@
+package document
+
+import (
+	"strings"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+// requiredValue is a value the templates of the chart fail to render without, as listed in the required values section.
+type requiredValue struct {
+	Key     string
+	Message string
+
+	// Description and anchor of the value in the values table, empty if the values file doesn't set the value
+	Description string
+	Anchor      string
+
+	// Key of the value in the format of helm install --set, with the first item of lists ranged over
+	SetKey string
+}
+
+// requiredKeyMatchesValue returns whether a required key refers to the value with the given key. The [] segments of
+// values required within a range match any list index or map key.
+func requiredKeyMatchesValue(keySegments []string, requiredSegments []string) bool {
+	return len(keySegments) == len(requiredSegments) && helm.ValueKeysMatch(keySegments, requiredSegments)
+}
+
+// getSetKey returns the key of a value in the format of helm install --set, which escapes the dots of quoted keys
+// rather than quoting them.
+func getSetKey(key string) string {
+	segments := helm.SplitValueKey(key)
+	for i, segment := range segments {
+		switch {
+		case segment == "[]":
+			segments[i] = "[0]"
+		case strings.HasPrefix(segment, `"`):
+			segments[i] = strings.ReplaceAll(strings.Trim(segment, `"`), ".", `\.`)
+		}
+	}
+
+	return helm.JoinValueKey(segments)
+}
+
+// setValueRowsRequired marks the value rows the templates of the chart fail to render without.
+func setValueRowsRequired(valueRows []valueRow, requiredValues []helm.RequiredValue) {
+	for _, required := range requiredValues {
+		requiredSegments := helm.SplitValueKey(required.Key)
+		for i := range valueRows {
+			if requiredKeyMatchesValue(helm.SplitValueKey(valueRows[i].Key), requiredSegments) {
+				valueRows[i].Required = true
+				valueRows[i].RequiredMessage = required.Message
+			}
+		}
+	}
+}
+
+// getRequiredValues returns the values the templates of the chart fail to render without, along with the description
+// and anchor of the first value row each refers to.
+func getRequiredValues(info helm.ChartDocumentationInfo, valueRows []valueRow) []requiredValue {
+	requiredValues := make([]requiredValue, 0, len(info.RequiredValues))
+	for _, required := range info.RequiredValues {
+		value := requiredValue{Key: required.Key, Message: required.Message, SetKey: getSetKey(required.Key)}
+		requiredSegments := helm.SplitValueKey(required.Key)
+		for _, row := range valueRows {
+			if requiredKeyMatchesValue(helm.SplitValueKey(row.Key), requiredSegments) {
+				value.Anchor = row.Anchor
+				value.Description = row.Description
+				if value.Description == "" {
+					value.Description = row.AutoDescription
+				}
+				break
+			}
+		}
+
+		requiredValues = append(requiredValues, value)
+	}
+
+	return requiredValues
+}
@@ -0,0 +1,73 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestGetSetKey(t *testing.T) {
+	assert.Equal(t, "auth.token", getSetKey("auth.token"))
+	assert.Equal(t, "users[0].name", getSetKey("users[].name"))
+	assert.Equal(t, "hosts[1]", getSetKey("hosts[1]"))
+	assert.Equal(t, `annotations.example\.com/name`, getSetKey(`annotations."example.com/name"`))
+}
+
+func TestSetValueRowsRequired(t *testing.T) {
+	rows := []valueRow{
+		{Key: "auth.token"},
+		{Key: "auth"},
+		{Key: "users[0].name"},
+		{Key: "users[1].name"},
+		{Key: "users[0].email"},
+	}
+
+	setValueRowsRequired(rows, []helm.RequiredValue{
+		{Key: "auth.token", Message: "a token is required"},
+		{Key: "users[].name"},
+	})
+
+	assert.True(t, rows[0].Required)
+	assert.Equal(t, "a token is required", rows[0].RequiredMessage)
+	assert.False(t, rows[1].Required)
+	assert.True(t, rows[2].Required)
+	assert.True(t, rows[3].Required)
+	assert.False(t, rows[4].Required)
+}
+
+func TestRequiredValuesTemplates(t *testing.T) {
+	helmValues := `
+auth:
+  # -- Token used to authenticate
+  token: ""
+	`
+
+	info := getTemplateTestChartInfo(t, helmValues)
+	info.RequiredValues = []helm.RequiredValue{
+		{Key: "auth.token", Message: "a token | key is required", File: "templates/secret.yaml", Line: 3},
+		{Key: `labels."app.kubernetes.io/team"`, File: "templates/deployment.yaml", Line: 5},
+	}
+
+	output := renderTestTemplate(t, info, "{{ template \"chart.valuesTable\" . }}\n\n{{ template \"chart.requiredValuesSection\" . }}", MarkdownOutputFormat)
+
+	assert.Equal(t, "| Key | Type | Default | Description |\n"+
+		"|-----|------|---------|-------------|\n"+
+		"| auth.token | string | `\"\"` | **Required** (a token \\| key is required) Token used to authenticate |\n\n"+
+		"## Required Values\n\n"+
+		"| Key | Message | Description |\n"+
+		"|-----|---------|-------------|\n"+
+		"| `auth.token` | a token \\| key is required | Token used to authenticate |\n"+
+		"| `labels.\"app.kubernetes.io/team\"` |  |  |\n\n"+
+		"```console\n"+
+		"$ helm install my-release my-chart \\\n"+
+		"    --set auth.token=<value> \\\n"+
+		"    --set labels.app\\.kubernetes\\.io/team=<value>\n"+
+		"```", output)
+
+	info.RequiredValues[0].Message = "a <token> is required"
+	htmlOutput := renderTestTemplate(t, info, `{{ template "chart.valuesTableHtml" . }}`, MarkdownOutputFormat)
+	assert.Contains(t, htmlOutput, "<td><strong>Required</strong> (a &lt;token&gt; is required) Token used to authenticate</td>")
+	assert.NotContains(t, htmlOutput, "**")
+}
@@ -0,0 +1,83 @@
+package document
+
+import (
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+// requirementRow is a row of the requirements table, with the values controlling whether the dependency is enabled
+// resolved to the rows documenting them.
+type requirementRow struct {
+	helm.ChartRequirementsItem
+
+	// Version the dependency is locked to in the lock file of the chart, if it has one
+	LockedVersion string
+
+	// Whether the dependency is enabled with the default values of the chart
+	EnabledByDefault bool
+	Conditions       []valueReference
+	TagValues        []valueReference
+	Imports          []helm.ChartImportValue
+}
+
+type requirements struct {
+	Dependencies []requirementRow
+
+	// Whether any dependency has a condition, tags, import-values or an enabled field, in which case the requirements
+	// table has columns for those used by any dependency
+	HasControls bool
+
+	// Whether any dependency can be disabled with a condition, tags or an enabled field, in which case the requirements
+	// table has a column for whether each dependency is enabled by default
+	HasToggles bool
+
+	// Whether any dependency has a condition, tags or import-values respectively, in which case the requirements table
+	// has a column for them
+	HasConditions bool
+	HasTags       bool
+	HasImports    bool
+
+	// Whether the chart has a lock file, in which case the requirements table has a column for the locked versions
+	Locked bool
+}
+
+// getRequirements returns the rows of the requirements table of a chart.
+func getRequirements(info helm.ChartDocumentationInfo, valueRows []valueRow, language string) requirements {
+	anchorsByKey := make(map[string]string, len(valueRows))
+	for _, row := range valueRows {
+		anchorsByKey[row.Key] = getValueAnchor(row.Key)
+	}
+
+	dependencyPaths := getDependencyDocumentationPaths(info, language)
+	resolve := func(key string) valueReference {
+		reference, _ := resolveValueReference(valueReference{Key: key}, anchorsByKey, dependencyPaths)
+		return reference
+	}
+
+	result := requirements{Dependencies: make([]requirementRow, 0, len(info.Dependencies)), Locked: info.ChartLock != nil}
+	for _, dep := range info.Dependencies {
+		row := requirementRow{
+			ChartRequirementsItem: dep,
+			LockedVersion:         info.ChartLock.GetLockedVersion(dep),
+			EnabledByDefault:      dep.IsEnabled(info.ChartValues),
+			Imports:               dep.GetImportValues(),
+		}
+
+		for _, condition := range dep.GetConditions() {
+			row.Conditions = append(row.Conditions, resolve(condition))
+		}
+
+		for _, tag := range dep.Tags {
+			row.TagValues = append(row.TagValues, resolve("tags."+tag))
+		}
+
+		result.HasConditions = result.HasConditions || len(row.Conditions) > 0
+		result.HasTags = result.HasTags || len(row.TagValues) > 0
+		result.HasImports = result.HasImports || len(row.Imports) > 0
+		result.HasToggles = result.HasToggles || len(row.Conditions) > 0 || len(row.TagValues) > 0 || dep.Enabled != nil
+		result.HasControls = result.HasToggles || result.HasImports
+
+		result.Dependencies = append(result.Dependencies, row)
+	}
+
+	return result
+}
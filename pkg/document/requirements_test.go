@@ -0,0 +1,105 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestGetRequirements(t *testing.T) {
+	viper.Set("output-file", "README.md")
+	viper.Set("value-anchors", true)
+	defer viper.Reset()
+
+	helmValues := parseYamlValues(`
+postgresql:
+  # -- Whether to deploy postgresql
+  enabled: false
+
+tags:
+  # -- Enables the monitoring stack
+  monitoring: true
+	`)
+
+	disabled := false
+	info := helm.ChartDocumentationInfo{
+		ChartDirectory: t.TempDir(),
+		ChartRequirements: helm.ChartRequirements{Dependencies: []helm.ChartRequirementsItem{
+			{Name: "postgresql", Condition: "postgresql.enabled", Repository: "https://charts.example.com"},
+			{Name: "prometheus", Tags: []string{"monitoring", "metrics"}, Repository: "https://charts.example.com"},
+			{Name: "redis", Enabled: &disabled, Repository: "file://../redis"},
+		}},
+		ChartLock: &helm.ChartLock{Dependencies: []helm.ChartLockItem{
+			{Name: "postgresql", Version: "12.1.0", Repository: "https://charts.example.com"},
+		}},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{helmValues}},
+		ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+	}
+
+	data, err := getChartTemplateData(info, "", nil, true, &translator{})
+	require.NoError(t, err)
+
+	assert.True(t, data.Requirements.HasControls)
+	assert.True(t, data.Requirements.HasToggles)
+	assert.True(t, data.Requirements.HasConditions)
+	assert.True(t, data.Requirements.HasTags)
+	assert.False(t, data.Requirements.HasImports)
+	assert.True(t, data.Requirements.Locked)
+	require.Len(t, data.Requirements.Dependencies, 3)
+
+	postgresql := data.Requirements.Dependencies[0]
+	assert.False(t, postgresql.EnabledByDefault)
+	assert.Equal(t, "12.1.0", postgresql.LockedVersion)
+	assert.Equal(t, []valueReference{{Key: "postgresql.enabled", Anchor: "value-postgresql.enabled"}}, postgresql.Conditions)
+
+	prometheus := data.Requirements.Dependencies[1]
+	assert.True(t, prometheus.EnabledByDefault)
+	assert.Equal(t, []valueReference{{Key: "tags.monitoring", Anchor: "value-tags.monitoring"}, {Key: "tags.metrics"}}, prometheus.TagValues)
+
+	assert.False(t, data.Requirements.Dependencies[2].EnabledByDefault)
+}
+
+func TestRequirementsTableWithoutControls(t *testing.T) {
+	requirements := getRequirements(helm.ChartDocumentationInfo{
+		ChartRequirements: helm.ChartRequirements{Dependencies: []helm.ChartRequirementsItem{{Name: "redis", Version: "17.0.0"}}},
+	}, nil, "")
+
+	assert.False(t, requirements.HasControls)
+	assert.False(t, requirements.Locked)
+	assert.True(t, requirements.Dependencies[0].EnabledByDefault)
+}
+
+func TestRequirementsTableColumns(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "tags:\n  backend: true\n")
+	info.Dependencies = []helm.ChartRequirementsItem{
+		{Name: "redis", Version: "17.0.0", Repository: "https://charts.example.com", Tags: []string{"backend"}},
+		{Name: "common", Version: "2.0.0", Repository: "https://charts.example.com"},
+	}
+
+	// Only the columns used by any dependency are rendered
+	assert.Equal(t, "| Repository | Name | Version | Enabled | Tags |\n"+
+		"|------------|------|---------|---------|------|\n"+
+		"| https://charts.example.com | redis | 17.0.0 | true | `tags.backend` |\n"+
+		"| https://charts.example.com | common | 2.0.0 | true |  |",
+		renderTestTemplate(t, info, `{{ template "chart.requirementsTable" . }}`, MarkdownOutputFormat))
+
+	assert.Equal(t, "[options=\"header\"]\n|===\n"+
+		"| Repository | Name | Version | Enabled | Tags\n"+
+		"| https://charts.example.com | redis | 17.0.0 | true | `+tags.backend+`\n"+
+		"| https://charts.example.com | common | 2.0.0 | true | \n|===",
+		renderTestTemplate(t, info, `{{ template "chart.requirementsTable" . }}`, AsciiDocOutputFormat))
+
+	info.Dependencies = []helm.ChartRequirementsItem{
+		{Name: "redis", Version: "17.0.0", Repository: "https://charts.example.com", ImportValues: []interface{}{"defaults"}},
+	}
+
+	assert.Equal(t, "| Repository | Name | Version | Import Values |\n"+
+		"|------------|------|---------|---------------|\n"+
+		"| https://charts.example.com | redis | 17.0.0 | `exports.defaults` → `.` |",
+		renderTestTemplate(t, info, `{{ template "chart.requirementsTable" . }}`, MarkdownOutputFormat))
+}
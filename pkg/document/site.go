@@ -0,0 +1,244 @@
+package document
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// siteOutputFormat is the format the references between values are linked in on the documentation site.
+const siteOutputFormat = "site"
+
+var htmlTagRegex = regexp.MustCompile(`<[^>]*>`)
+
+//go:embed site
+var siteFiles embed.FS
+
+type sitePage struct {
+	HelmDocsVersion string
+	Title           string
+	Charts          []siteChart
+	Chart           siteChart
+	SearchIndex     []siteSearchEntry
+}
+
+type siteChart struct {
+	ChartDocumentationModel
+	Page          string
+	ValueSections []siteValueSection
+}
+
+type siteValueSection struct {
+	Name    string
+	Anchor  string
+	Default bool
+	Values  []ValueModel
+}
+
+type siteSearchEntry struct {
+	Name        string            `json:"name"`
+	Page        string            `json:"page"`
+	Description string            `json:"description"`
+	Values      []siteSearchValue `json:"values"`
+}
+
+type siteSearchValue struct {
+	Key         string `json:"key"`
+	Anchor      string `json:"anchor"`
+	Description string `json:"description"`
+}
+
+// getSitePage returns the page a chart is rendered to, named after its directory relative to the chart search root so
+// that charts of the same name in different directories don't collide.
+func getSitePage(chartSearchRoot string, chartDirectory string) string {
+	relativeDirectory, err := filepath.Rel(chartSearchRoot, chartDirectory)
+	if err != nil || relativeDirectory == "." || strings.HasPrefix(relativeDirectory, "..") {
+		relativeDirectory = filepath.Base(chartDirectory)
+	}
+
+	page := anchorInvalidCharactersRegex.ReplaceAllString(filepath.ToSlash(relativeDirectory), "-")
+	if page == "index" {
+		page = "index-chart"
+	}
+
+	return page + ".html"
+}
+
+func getSiteChart(chartSearchRoot string, model ChartDocumentationModel) siteChart {
+	valuesByKey := make(map[string]ValueModel, len(model.Values))
+	for _, value := range model.Values {
+		valuesByKey[value.Key] = value
+	}
+
+	valueSections := make([]siteValueSection, 0, len(model.Sections))
+	for _, s := range model.Sections {
+		valueSection := siteValueSection{Name: s.Name, Anchor: getSectionAnchor(s.Name), Default: s.Default}
+		for _, key := range s.Keys {
+			valueSection.Values = append(valueSection.Values, valuesByKey[key])
+		}
+
+		valueSections = append(valueSections, valueSection)
+	}
+
+	return siteChart{
+		ChartDocumentationModel: model,
+		Page:                    getSitePage(chartSearchRoot, model.Directory),
+		ValueSections:           valueSections,
+	}
+}
+
+// highlightDefault renders a default value with JSON syntax highlighting.
+func highlightDefault(value interface{}) template.HTML {
+	var indented bytes.Buffer
+	encoder := json.NewEncoder(&indented)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return template.HTML(template.HTMLEscapeString(fmt.Sprint(value)))
+	}
+
+	source := strings.TrimSuffix(indented.String(), "\n")
+	var highlighted strings.Builder
+
+	writeToken := func(class string, token string) {
+		highlighted.WriteString(`<span class="` + class + `">`)
+		highlighted.WriteString(template.HTMLEscapeString(token))
+		highlighted.WriteString("</span>")
+	}
+
+	for i := 0; i < len(source); {
+		switch c := source[i]; {
+		case c == '"':
+			end := i + 1
+			for end < len(source) && source[end] != '"' {
+				if source[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			end++
+			class := "string"
+			if strings.HasPrefix(strings.TrimLeft(source[end:], " "), ":") {
+				class = "key"
+			}
+
+			writeToken(class, source[i:end])
+			i = end
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(source) && strings.IndexByte("0123456789.eE+-", source[end]) >= 0 {
+				end++
+			}
+
+			writeToken("number", source[i:end])
+			i = end
+		case c == 't' || c == 'f' || c == 'n':
+			end := i + 1
+			for end < len(source) && source[end] >= 'a' && source[end] <= 'z' {
+				end++
+			}
+
+			writeToken("literal", source[i:end])
+			i = end
+		default:
+			highlighted.WriteString(template.HTMLEscapeString(string(c)))
+			i++
+		}
+	}
+
+	return template.HTML(highlighted.String())
+}
+
+// descriptionHTML marks a value description of the site documentation model as HTML, its text is escaped already.
+func descriptionHTML(description string) template.HTML {
+	return template.HTML(description)
+}
+
+// descriptionText returns the text of a value description of the site documentation model, without its links.
+func descriptionText(description string) string {
+	return html.UnescapeString(htmlTagRegex.ReplaceAllString(description, ""))
+}
+
+func newSiteTemplate() (*template.Template, error) {
+	return template.New("site").Funcs(template.FuncMap{
+		"highlightDefault": highlightDefault,
+		"descriptionHTML":  descriptionHTML,
+		"descriptionText":  descriptionText,
+	}).ParseFS(siteFiles, "site/*.html")
+}
+
+func writeSitePage(siteTemplate *template.Template, name string, page sitePage, outputPath string) error {
+	var output bytes.Buffer
+	if err := siteTemplate.ExecuteTemplate(&output, name, page); err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputPath, output.Bytes(), 0o644)
+}
+
+// WriteSite renders the site documentation models of the given charts into a static HTML site in the output directory,
+// with a page per chart and an index page to search them. All assets the site uses are written along with it.
+func WriteSite(outputDirectory string, chartSearchRoot string, models []ChartDocumentationModel, helmDocsVersion string) error {
+	siteTemplate, err := newSiteTemplate()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(outputDirectory, "assets"), 0o755); err != nil {
+		return err
+	}
+
+	assets, err := fs.Sub(siteFiles, "site/assets")
+	if err != nil {
+		return err
+	}
+
+	err = fs.WalkDir(assets, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		content, err := fs.ReadFile(assets, path)
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(filepath.Join(outputDirectory, "assets", path), content, 0o644)
+	})
+	if err != nil {
+		return err
+	}
+
+	charts := make([]siteChart, 0, len(models))
+	searchIndex := make([]siteSearchEntry, 0, len(models))
+	for _, model := range models {
+		chart := getSiteChart(chartSearchRoot, model)
+		charts = append(charts, chart)
+
+		searchEntry := siteSearchEntry{Name: model.Chart.Name, Page: chart.Page, Description: model.Chart.Description}
+		for _, value := range model.Values {
+			searchEntry.Values = append(searchEntry.Values, siteSearchValue{Key: value.Key, Anchor: value.Anchor, Description: descriptionText(value.Description)})
+		}
+
+		searchIndex = append(searchIndex, searchEntry)
+	}
+
+	for _, chart := range charts {
+		page := sitePage{HelmDocsVersion: helmDocsVersion, Title: chart.Chart.Name, Charts: charts, Chart: chart}
+		if err := writeSitePage(siteTemplate, "chart.html", page, filepath.Join(outputDirectory, chart.Page)); err != nil {
+			return err
+		}
+	}
+
+	index := sitePage{HelmDocsVersion: helmDocsVersion, Title: "Charts", Charts: charts, SearchIndex: searchIndex}
+	return writeSitePage(siteTemplate, "index.html", index, filepath.Join(outputDirectory, "index.html"))
+}
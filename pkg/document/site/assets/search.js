@@ -0,0 +1,79 @@
+(function () {
+  "use strict";
+
+  function matches(element, terms) {
+    var text = (element.getAttribute("data-search") || "").toLowerCase();
+    return terms.every(function (term) { return text.indexOf(term) >= 0; });
+  }
+
+  function searchTerms(input) {
+    return input.value.toLowerCase().split(/\s+/).filter(function (term) { return term !== ""; });
+  }
+
+  // Index page: filter the charts table and list the values matching the search
+  var search = document.getElementById("search");
+  var searchIndex = document.getElementById("search-index");
+  if (search && searchIndex) {
+    var charts = JSON.parse(searchIndex.textContent) || [];
+    var results = document.getElementById("search-results");
+
+    search.addEventListener("input", function () {
+      var terms = searchTerms(search);
+      document.querySelectorAll("#charts tbody tr").forEach(function (row) {
+        row.hidden = terms.length > 0 && !matches(row, terms);
+      });
+
+      results.innerHTML = "";
+      if (terms.length === 0) {
+        results.hidden = true;
+        return;
+      }
+
+      charts.forEach(function (chart) {
+        (chart.values || []).forEach(function (value) {
+          var text = (chart.name + " " + value.key + " " + value.description).toLowerCase();
+          if (results.children.length >= 50 || !terms.every(function (term) { return text.indexOf(term) >= 0; })) {
+            return;
+          }
+
+          var link = document.createElement("a");
+          link.href = chart.page + "#" + value.anchor;
+          link.textContent = chart.name + ": " + value.key;
+
+          var item = document.createElement("li");
+          item.appendChild(link);
+          if (value.description) {
+            item.appendChild(document.createTextNode(" - " + value.description));
+          }
+
+          results.appendChild(item);
+        });
+      });
+
+      results.hidden = results.children.length === 0;
+    });
+  }
+
+  // Chart pages: filter the rows of the values tables
+  document.querySelectorAll(".values-filter").forEach(function (filter) {
+    filter.addEventListener("input", function () {
+      var terms = searchTerms(filter);
+      document.querySelectorAll("table.values tbody tr").forEach(function (row) {
+        row.hidden = terms.length > 0 && !matches(row, terms);
+      });
+    });
+  });
+
+  // Open the collapsed section containing the value linked to
+  function openTarget() {
+    var target = window.location.hash && document.getElementById(window.location.hash.substring(1));
+    for (var element = target; element; element = element.parentElement) {
+      if (element.tagName === "DETAILS") {
+        element.open = true;
+      }
+    }
+  }
+
+  window.addEventListener("hashchange", openTarget);
+  openTarget();
+})();
@@ -0,0 +1,76 @@
+package document
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestHighlightDefault(t *testing.T) {
+	assert.Equal(t, `{
+  <span class="key">&#34;enabled&#34;</span>: <span class="literal">true</span>,
+  <span class="key">&#34;port&#34;</span>: <span class="number">-80</span>
+}`, string(highlightDefault(map[string]interface{}{"enabled": true, "port": -80})))
+
+	assert.Equal(t, "[\n  <span class=\"string\">&#34;a&lt;b&#34;</span>\n]", string(highlightDefault([]interface{}{"a<b"})))
+	assert.Equal(t, `<span class="literal">null</span>`, string(highlightDefault(nil)))
+	assert.Equal(t, `<span class="string">&#34;&lt;computed&gt;&#34;</span>`, string(highlightDefault("<computed>")))
+}
+
+func TestDescriptionText(t *testing.T) {
+	assert.Equal(t, "Pulled with image.pullPolicy & replicas", descriptionText(`Pulled with <a href="#value-image.pullPolicy">image.pullPolicy</a> &amp; <code>replicas</code>`))
+}
+
+func TestGetSiteDocumentationModel(t *testing.T) {
+	info := getExportTestChartInfo(t)
+	info.ChartValuesDescriptions["image"] = helm.ChartValueDescription{Description: "The <image>, see [[replicas]] and [[unknown.key]]"}
+
+	model, err := GetSiteDocumentationModel(info, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `The &lt;image&gt;, see <a href="#value-replicas">replicas</a> and <code>unknown.key</code>`, model.Values[0].Description)
+
+	model, err = GetDocumentationModel(info, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "The <image>, see [[replicas]] and [[unknown.key]]", model.Values[0].Description)
+}
+
+func TestGetSitePage(t *testing.T) {
+	assert.Equal(t, "my-chart.html", getSitePage("charts", "charts/my-chart"))
+	assert.Equal(t, "umbrella-charts-sub-a.html", getSitePage("charts", "charts/umbrella/charts/sub-a"))
+	assert.Equal(t, "my-chart.html", getSitePage("charts/my-chart", "charts/my-chart"))
+	assert.Equal(t, "index-chart.html", getSitePage("charts", "charts/index"))
+}
+
+func TestWriteSite(t *testing.T) {
+	info := getExportTestChartInfo(t)
+	info.ChartValuesDescriptions["image"] = helm.ChartValueDescription{Description: "The image, scaled with [[replicas]]"}
+	model, err := GetSiteDocumentationModel(info, "", nil)
+	require.NoError(t, err)
+
+	outputDirectory := t.TempDir()
+	require.NoError(t, WriteSite(outputDirectory, filepath.Dir(info.ChartDirectory), []ChartDocumentationModel{model}, "1.2.3"))
+
+	for _, asset := range []string{"assets/style.css", "assets/search.js"} {
+		assert.FileExists(t, filepath.Join(outputDirectory, asset))
+	}
+
+	index, err := os.ReadFile(filepath.Join(outputDirectory, "index.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(index), `<a href="`+filepath.Base(info.ChartDirectory)+`.html">my-chart</a>`)
+	assert.Contains(t, string(index), `"key":"replicas","anchor":"value-replicas"`)
+
+	page, err := os.ReadFile(filepath.Join(outputDirectory, filepath.Base(info.ChartDirectory)+".html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(page), `<details id="section-scaling" open>`)
+	assert.Contains(t, string(page), `<tr id="value-replicas" data-search="replicas Number of replicas">`)
+	assert.Contains(t, string(page), `<span class="number">1</span>`)
+	assert.Contains(t, string(page), `<span class="string">&#34;nginx&#34;</span>`)
+	assert.Contains(t, string(page), `data-search="image The image, scaled with replicas"`)
+	assert.Contains(t, string(page), `The image, scaled with <a href="#value-replicas">replicas</a>`)
+	assert.Contains(t, string(page), `helm-docs v1.2.3`)
+}
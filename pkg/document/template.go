@@ -55,6 +55,21 @@ func getHeaderTemplate() string {
 	return headerTemplateBuilder.String()
 }
 
+func getIconTemplates() string {
+	iconBuilder := strings.Builder{}
+	iconBuilder.WriteString(`{{ define "chart.icon" }}{{ .Icon }}{{ end }}\n`)
+	iconBuilder.WriteString(`{{ define "chart.iconImage" }}`)
+	iconBuilder.WriteString(`{{ if .Icon }}<img src="{{ .Icon }}" alt="{{ .Name }}" height="64">{{ end }}`)
+	iconBuilder.WriteString("{{ end }}")
+
+	iconBuilder.WriteString(`{{ define "chart.iconHeader" }}`)
+	iconBuilder.WriteString(`{{ if .Icon }}# <img src="{{ .Icon }}" alt="" height="32"> {{ .Name }}`)
+	iconBuilder.WriteString("\n{{ else }}{{ template \"chart.header\" . }}{{ end }}")
+	iconBuilder.WriteString("{{ end }}")
+
+	return iconBuilder.String()
+}
+
 func getDeprecatedTemplate() string {
 	deprecatedTemplateBuilder := strings.Builder{}
 	deprecatedTemplateBuilder.WriteString(`{{ define "chart.deprecationWarning" }}`)
@@ -122,9 +137,19 @@ func getHomepageTemplate() string {
 	return homepageBuilder.String()
 }
 
+func getKeywordsTemplates() string {
+	keywordsBuilder := strings.Builder{}
+	keywordsBuilder.WriteString(`{{ define "chart.keywords" }}{{ join ", " .Keywords }}{{ end }}\n`)
+	keywordsBuilder.WriteString(`{{ define "chart.keywordsLine" }}`)
+	keywordsBuilder.WriteString("{{ if .Keywords }}**Keywords:**{{ range .Keywords }} `{{ . }}`{{ end }}{{ end }}")
+	keywordsBuilder.WriteString("{{ end }}")
+
+	return keywordsBuilder.String()
+}
+
 func getMaintainersTemplate() string {
 	maintainerBuilder := strings.Builder{}
-	maintainerBuilder.WriteString(`{{ define "chart.maintainersHeader" }}## Maintainers{{ end }}`)
+	maintainerBuilder.WriteString(`{{ define "chart.maintainersHeader" }}## {{ translate "Maintainers" }}{{ end }}`)
 
 	maintainerBuilder.WriteString(`{{ define "chart.maintainersTable" }}`)
 	maintainerBuilder.WriteString("| Name | Email | URL |\n")
@@ -147,7 +172,7 @@ func getMaintainersTemplate() string {
 
 func getSourceLinkTemplates() string {
 	sourceLinkBuilder := strings.Builder{}
-	sourceLinkBuilder.WriteString(`{{ define "chart.sourcesHeader" }}## Source Code{{ end}}`)
+	sourceLinkBuilder.WriteString(`{{ define "chart.sourcesHeader" }}## {{ translate "Source Code" }}{{ end}}`)
 
 	sourceLinkBuilder.WriteString(`{{ define "chart.sourcesList" }}`)
 	sourceLinkBuilder.WriteString("{{- range .Sources }}")
@@ -166,9 +191,265 @@ func getSourceLinkTemplates() string {
 	return sourceLinkBuilder.String()
 }
 
+func getArtifactHubTemplates() string {
+	artifactHubBuilder := strings.Builder{}
+	artifactHubBuilder.WriteString(`{{ define "chart.changelogHeader" }}## {{ translate "Changelog" }}{{ end }}`)
+
+	artifactHubBuilder.WriteString(`{{ define "chart.changelogList" }}`)
+	artifactHubBuilder.WriteString("{{- range .ArtifactHub.Changes }}")
+	artifactHubBuilder.WriteString("\n* {{ if .Kind }}**{{ .Kind | title }}:** {{ end }}{{ .Description }}{{ range .Links }} ([{{ .Name }}]({{ .URL }})){{ end }}")
+	artifactHubBuilder.WriteString("{{- end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.changelogSection" }}`)
+	artifactHubBuilder.WriteString("{{ if .ArtifactHub.Changes }}")
+	artifactHubBuilder.WriteString(`{{ template "chart.changelogHeader" . }}`)
+	artifactHubBuilder.WriteString("\n\n### {{ .Version }}\n")
+	artifactHubBuilder.WriteString(`{{ template "chart.changelogList" . }}`)
+	artifactHubBuilder.WriteString("{{ end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.imagesHeader" }}## {{ translate "Images" }}{{ end }}`)
+
+	artifactHubBuilder.WriteString(`{{ define "chart.imagesTable" }}`)
+	artifactHubBuilder.WriteString("| Name | Image | Platforms |\n")
+	artifactHubBuilder.WriteString("|------|-------|-----------|")
+	artifactHubBuilder.WriteString("  {{- range .ArtifactHub.Images }}")
+	artifactHubBuilder.WriteString("\n| {{ .Name }} | `{{ .Image }}` | {{ join \", \" .Platforms }} |")
+	artifactHubBuilder.WriteString("  {{- end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.imagesSection" }}`)
+	artifactHubBuilder.WriteString("{{ if .ArtifactHub.Images }}")
+	artifactHubBuilder.WriteString(`{{ template "chart.imagesHeader" . }}`)
+	artifactHubBuilder.WriteString("\n\n")
+	artifactHubBuilder.WriteString(`{{ template "chart.imagesTable" . }}`)
+	artifactHubBuilder.WriteString("{{ end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.linksHeader" }}## {{ translate "Links" }}{{ end }}`)
+
+	artifactHubBuilder.WriteString(`{{ define "chart.linksList" }}`)
+	artifactHubBuilder.WriteString("{{- range .ArtifactHub.Links }}")
+	artifactHubBuilder.WriteString("\n* [{{ .Name }}]({{ .URL }})")
+	artifactHubBuilder.WriteString("{{- end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	artifactHubBuilder.WriteString(`{{ define "chart.linksSection" }}`)
+	artifactHubBuilder.WriteString("{{ if .ArtifactHub.Links }}")
+	artifactHubBuilder.WriteString(`{{ template "chart.linksHeader" . }}`)
+	artifactHubBuilder.WriteString("\n")
+	artifactHubBuilder.WriteString(`{{ template "chart.linksList" . }}`)
+	artifactHubBuilder.WriteString("{{ end }}")
+	artifactHubBuilder.WriteString("{{ end }}")
+
+	return artifactHubBuilder.String()
+}
+
+func getCustomResourceDefinitionsTemplates() string {
+	crdsBuilder := strings.Builder{}
+	crdsBuilder.WriteString(`{{ define "chart.crdsHeader" }}## {{ translate "Custom Resource Definitions" }}{{ end }}`)
+
+	crdsBuilder.WriteString(`{{ define "chart.crdVersionTable" }}`)
+	crdsBuilder.WriteString("| Field | Type | Required | Description |\n")
+	crdsBuilder.WriteString("|-------|------|----------|-------------|")
+	crdsBuilder.WriteString("  {{- range .Properties }}")
+	crdsBuilder.WriteString("\n| `{{ .Path }}` | {{ .Type }} | {{ .Required }} | {{ .Description | escapeCell }} |")
+	crdsBuilder.WriteString("  {{- end }}")
+	crdsBuilder.WriteString("{{ end }}")
+
+	crdsBuilder.WriteString(`{{ define "chart.crdsTables" }}`)
+	crdsBuilder.WriteString("{{- range $crd := .CustomResourceDefinitions }}")
+	crdsBuilder.WriteString("\n\n### {{ .Kind }}\n\n`{{ .Name }}`, {{ .Scope }}{{ with .File }}, defined in [{{ . }}]({{ . }}){{ end }}")
+	crdsBuilder.WriteString("{{- range .Versions }}")
+	crdsBuilder.WriteString("\n\n#### {{ $crd.Group }}/{{ .Name }}")
+	crdsBuilder.WriteString("{{- if .Deprecated }}\n\n> **{{ translate \"Deprecated\" }}**{{ with .DeprecationWarning }}: {{ . }}{{ end }}{{ end }}")
+	crdsBuilder.WriteString("{{- if .Properties }}\n\n{{ template \"chart.crdVersionTable\" . }}{{ end }}")
+	crdsBuilder.WriteString("{{- end }}")
+	crdsBuilder.WriteString("{{- range .Examples }}\n\n#### {{ translate \"Example\" }}\n\n```yaml\n{{ .Yaml }}```{{ end }}")
+	crdsBuilder.WriteString("{{- end }}")
+	crdsBuilder.WriteString("{{ end }}")
+
+	crdsBuilder.WriteString(`{{ define "chart.crdsSection" }}`)
+	crdsBuilder.WriteString("{{ if .CustomResourceDefinitions }}")
+	crdsBuilder.WriteString(`{{ template "chart.crdsHeader" . }}`)
+	crdsBuilder.WriteString(`{{ template "chart.crdsTables" . }}`)
+	crdsBuilder.WriteString("{{ end }}")
+	crdsBuilder.WriteString("{{ end }}")
+
+	return crdsBuilder.String()
+}
+
+func getNamedTemplatesTemplates() string {
+	namedTemplatesBuilder := strings.Builder{}
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplatesHeader" }}## {{ translate "Named Templates" }}{{ end }}`)
+
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplateParametersTable" }}`)
+	namedTemplatesBuilder.WriteString("| Parameter | Type | Description |\n")
+	namedTemplatesBuilder.WriteString("|-----------|------|-------------|")
+	namedTemplatesBuilder.WriteString("  {{- range .Parameters }}")
+	namedTemplatesBuilder.WriteString("\n| `{{ .Name }}` | {{ .Type }} | {{ .Description | escapeCell }} |")
+	namedTemplatesBuilder.WriteString("  {{- end }}")
+	namedTemplatesBuilder.WriteString("{{ end }}")
+
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplatesList" }}`)
+	namedTemplatesBuilder.WriteString("{{- range .NamedTemplates }}")
+	namedTemplatesBuilder.WriteString("\n\n### `{{ .Name }}`")
+	namedTemplatesBuilder.WriteString("{{- with .Description }}\n\n{{ . }}{{ end }}")
+	namedTemplatesBuilder.WriteString("\n\n{{ translate \"Defined in\" }} [{{ .File }}]({{ .File }}#L{{ .Line }})")
+	namedTemplatesBuilder.WriteString("{{- if .Parameters }}\n\n{{ template \"chart.namedTemplateParametersTable\" . }}{{ end }}")
+	namedTemplatesBuilder.WriteString("{{- with .Example }}\n\n```\n{{ . }}\n```{{ end }}")
+	namedTemplatesBuilder.WriteString("{{- end }}")
+	namedTemplatesBuilder.WriteString("{{ end }}")
+
+	namedTemplatesBuilder.WriteString(`{{ define "chart.namedTemplatesSection" }}`)
+	namedTemplatesBuilder.WriteString("{{ if .NamedTemplates }}")
+	namedTemplatesBuilder.WriteString(`{{ template "chart.namedTemplatesHeader" . }}`)
+	namedTemplatesBuilder.WriteString(`{{ template "chart.namedTemplatesList" . }}`)
+	namedTemplatesBuilder.WriteString("{{ end }}")
+	namedTemplatesBuilder.WriteString("{{ end }}")
+
+	return namedTemplatesBuilder.String()
+}
+
+func getRequiredValuesTemplates() string {
+	requiredValuesBuilder := strings.Builder{}
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesHeader" }}## {{ translate "Required Values" }}{{ end }}`)
+
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesTable" }}`)
+	requiredValuesBuilder.WriteString("| Key | Message | Description |\n")
+	requiredValuesBuilder.WriteString("|-----|---------|-------------|")
+	requiredValuesBuilder.WriteString("  {{- range .RequiredValues }}")
+	requiredValuesBuilder.WriteString("\n| {{ if .Anchor }}[{{ .Key }}](#{{ .Anchor }}){{ else }}`{{ .Key }}`{{ end }} | {{ .Message | escapeCell }} | {{ .Description }} |")
+	requiredValuesBuilder.WriteString("  {{- end }}")
+	requiredValuesBuilder.WriteString("{{ end }}")
+
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesInstall" }}`)
+	requiredValuesBuilder.WriteString("```console\n")
+	requiredValuesBuilder.WriteString("$ helm install my-release {{ .Name }}")
+	requiredValuesBuilder.WriteString("{{ range .RequiredValues }} \\\n    --set {{ .SetKey }}=<value>{{ end }}")
+	requiredValuesBuilder.WriteString("\n```")
+	requiredValuesBuilder.WriteString("{{ end }}")
+
+	requiredValuesBuilder.WriteString(`{{ define "chart.requiredValuesSection" }}`)
+	requiredValuesBuilder.WriteString("{{ if .RequiredValues }}")
+	requiredValuesBuilder.WriteString(`{{ template "chart.requiredValuesHeader" . }}`)
+	requiredValuesBuilder.WriteString("\n\n")
+	requiredValuesBuilder.WriteString(`{{ template "chart.requiredValuesTable" . }}`)
+	requiredValuesBuilder.WriteString("\n\n")
+	requiredValuesBuilder.WriteString(`{{ template "chart.requiredValuesInstall" . }}`)
+	requiredValuesBuilder.WriteString("{{ end }}")
+	requiredValuesBuilder.WriteString("{{ end }}")
+
+	return requiredValuesBuilder.String()
+}
+
+func getRenderedResourcesTemplates() string {
+	resourcesBuilder := strings.Builder{}
+	resourcesBuilder.WriteString(`{{ define "chart.resourcesHeader" }}## {{ translate "Resources Created" }}{{ end }}`)
+
+	resourcesBuilder.WriteString(`{{ define "chart.resourcesTable" }}`)
+	resourcesBuilder.WriteString("| Kind | API Version | Name | Scope | Template |\n")
+	resourcesBuilder.WriteString("|------|-------------|------|-------|----------|")
+	resourcesBuilder.WriteString("  {{- range .RenderedResources }}")
+	resourcesBuilder.WriteString("\n| {{ .Kind }}{{ with .Hook }} ({{ translate \"hook\" }} {{ . }}){{ end }} | {{ .APIVersion }} | `{{ .Name }}` | ")
+	resourcesBuilder.WriteString("{{ if .Namespaced }}Namespaced{{ with .Namespace }} (`{{ . }}`){{ end }}{{ else }}Cluster{{ end }} | [{{ .File }}]({{ .File }}) |")
+	resourcesBuilder.WriteString("  {{- end }}")
+	resourcesBuilder.WriteString("{{ end }}")
+
+	resourcesBuilder.WriteString(`{{ define "chart.resourcesSection" }}`)
+	resourcesBuilder.WriteString("{{ if .RenderedResources }}")
+	resourcesBuilder.WriteString(`{{ template "chart.resourcesHeader" . }}`)
+	resourcesBuilder.WriteString("\n\n")
+	resourcesBuilder.WriteString(`{{ template "chart.resourcesTable" . }}`)
+	resourcesBuilder.WriteString("{{ end }}")
+	resourcesBuilder.WriteString("{{ end }}")
+
+	return resourcesBuilder.String()
+}
+
+func getRBACTemplates() string {
+	rbacBuilder := strings.Builder{}
+	rbacBuilder.WriteString(`{{ define "chart.rbacHeader" }}## {{ translate "RBAC Permissions" }}{{ end }}`)
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacTogglesRenderMd" }}`)
+	rbacBuilder.WriteString(`{{ with .Toggles }} ({{ translate "toggled by" }} {{ range $i, $key := . }}{{ if $i }}, {{ end }}` + "`{{ $key }}`" + `{{ end }}){{ end }}`)
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacRuleRenderMd" }}`)
+	rbacBuilder.WriteString(" {{ range $i, $group := .APIGroups }}{{ if $i }}, {{ end }}{{ if $group }}`{{ $group }}`{{ else }}core{{ end }}{{ end }} |")
+	rbacBuilder.WriteString(" {{ range $i, $resource := .Resources }}{{ if $i }}, {{ end }}`{{ $resource }}`{{ end }}")
+	rbacBuilder.WriteString("{{ with .ResourceNames }} ({{ range $i, $name := . }}{{ if $i }}, {{ end }}`{{ $name }}`{{ end }}){{ end }}")
+	rbacBuilder.WriteString("{{ range $i, $url := .NonResourceURLs }}{{ if or $i $.Resources }}, {{ end }}`{{ $url }}`{{ end }} |")
+	rbacBuilder.WriteString(" {{ range $i, $verb := .Verbs }}{{ if $i }}, {{ end }}`{{ $verb }}`{{ end }} |")
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacRulesTable" }}`)
+	rbacBuilder.WriteString("| Role | API Groups | Resources | Verbs |\n")
+	rbacBuilder.WriteString("|------|------------|-----------|-------|")
+	rbacBuilder.WriteString("  {{- range $role := .RBAC.Roles }}")
+	rbacBuilder.WriteString("    {{- range .Rules }}")
+	rbacBuilder.WriteString("\n| {{ $role.Kind }} `{{ $role.Name }}`{{ template \"chart.rbacTogglesRenderMd\" $role }} |{{ template \"chart.rbacRuleRenderMd\" . }}")
+	rbacBuilder.WriteString("    {{- else }}")
+	rbacBuilder.WriteString("\n| {{ $role.Kind }} `{{ $role.Name }}`{{ template \"chart.rbacTogglesRenderMd\" $role }} |  |  |  |")
+	rbacBuilder.WriteString("    {{- end }}")
+	rbacBuilder.WriteString("  {{- end }}")
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacBindingsTable" }}`)
+	rbacBuilder.WriteString("| Binding | Role | Subjects |\n")
+	rbacBuilder.WriteString("|---------|------|----------|")
+	rbacBuilder.WriteString("  {{- range .RBAC.Bindings }}")
+	rbacBuilder.WriteString("\n| {{ .Kind }} `{{ .Name }}`{{ template \"chart.rbacTogglesRenderMd\" . }} | {{ .RoleRef.Kind }} `{{ .RoleRef.Name }}` |")
+	rbacBuilder.WriteString(" {{ range $i, $subject := .Subjects }}{{ if $i }}, {{ end }}{{ .Kind }} `{{ .Name }}`{{ with .Namespace }} ({{ translate \"in\" }} `{{ . }}`){{ end }}{{ end }} |")
+	rbacBuilder.WriteString("  {{- end }}")
+	rbacBuilder.WriteString("{{ end }}")
+
+	rbacBuilder.WriteString(`{{ define "chart.rbacSection" }}`)
+	rbacBuilder.WriteString("{{ if or .RBAC.Roles .RBAC.Bindings }}")
+	rbacBuilder.WriteString(`{{ template "chart.rbacHeader" . }}`)
+	rbacBuilder.WriteString("{{ if .RBAC.Roles }}\n\n{{ template \"chart.rbacRulesTable\" . }}{{ end }}")
+	rbacBuilder.WriteString("{{ if .RBAC.Bindings }}\n\n{{ template \"chart.rbacBindingsTable\" . }}{{ end }}")
+	rbacBuilder.WriteString("{{ end }}")
+	rbacBuilder.WriteString("{{ end }}")
+
+	return rbacBuilder.String()
+}
+
+func getKubeCompatibilityTemplates() string {
+	kubeCompatibilityBuilder := strings.Builder{}
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeCompatibilityHeader" }}## {{ translate "Kubernetes Compatibility" }}{{ end }}`)
+
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeAPIChangesRenderMd" }}`)
+	kubeCompatibilityBuilder.WriteString("{{ range $i, $api := . }}{{ if $i }}, {{ end }}`{{ .APIVersion }}` {{ .Kind }}")
+	kubeCompatibilityBuilder.WriteString(`{{ with .Replacement }} ({{ translate "use" }} ` + "`{{ . }}`" + `){{ end }}{{ end }}`)
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeCompatibilityTable" }}`)
+	kubeCompatibilityBuilder.WriteString("| Kubernetes | Supported | Removed APIs | Deprecated APIs |\n")
+	kubeCompatibilityBuilder.WriteString("|------------|-----------|--------------|-----------------|")
+	kubeCompatibilityBuilder.WriteString("  {{- range .KubernetesCompatibility }}")
+	kubeCompatibilityBuilder.WriteString("\n| {{ .Version }} | {{ if .Partial }}{{ translate \"Partially\" }}{{ else if .Supported }}{{ translate \"Yes\" }}{{ else }}{{ translate \"No\" }}{{ end }} |")
+	kubeCompatibilityBuilder.WriteString(` {{ template "chart.kubeAPIChangesRenderMd" .RemovedAPIs }} | {{ template "chart.kubeAPIChangesRenderMd" .DeprecatedAPIs }} |`)
+	kubeCompatibilityBuilder.WriteString("  {{- end }}")
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+
+	kubeCompatibilityBuilder.WriteString(`{{ define "chart.kubeCompatibilitySection" }}`)
+	kubeCompatibilityBuilder.WriteString("{{ if .KubernetesCompatibility }}")
+	kubeCompatibilityBuilder.WriteString(`{{ template "chart.kubeCompatibilityHeader" . }}`)
+	kubeCompatibilityBuilder.WriteString("\n\n")
+	kubeCompatibilityBuilder.WriteString(`{{ template "chart.kubeVersionLine" . }}`)
+	kubeCompatibilityBuilder.WriteString("\n\n")
+	kubeCompatibilityBuilder.WriteString(`{{ template "chart.kubeCompatibilityTable" . }}`)
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+	kubeCompatibilityBuilder.WriteString("{{ end }}")
+
+	return kubeCompatibilityBuilder.String()
+}
+
 func getRequirementsTableTemplates() string {
 	requirementsSectionBuilder := strings.Builder{}
-	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsHeader" }}## Requirements{{ end }}`)
+	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsHeader" }}## {{ translate "Requirements" }}{{ end }}`)
 
 	requirementsSectionBuilder.WriteString(`{{ define "chart.kubeVersion" }}{{ .KubeVersion }}{{ end }}\n`)
 	requirementsSectionBuilder.WriteString(`{{ define "chart.kubeVersionLine" }}`)
@@ -176,14 +457,38 @@ func getRequirementsTableTemplates() string {
 	requirementsSectionBuilder.WriteString("{{ end }}")
 
 	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsTable" }}`)
-	requirementsSectionBuilder.WriteString("| Repository | Name | Version |\n")
-	requirementsSectionBuilder.WriteString("|------------|------|---------|")
-	requirementsSectionBuilder.WriteString("  {{- range .Dependencies }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasControls }}")
+	requirementsSectionBuilder.WriteString(`{{ template "chart.requirementsControlsTable" . }}`)
+	requirementsSectionBuilder.WriteString("{{ else }}")
+	requirementsSectionBuilder.WriteString("| Repository | Name | Version |{{ if .Requirements.Locked }} Locked Version |{{ end }}\n")
+	requirementsSectionBuilder.WriteString("|------------|------|---------|{{ if .Requirements.Locked }}----------------|{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- range .Requirements.Dependencies }}")
 	requirementsSectionBuilder.WriteString("    {{- if .Alias }}")
 	requirementsSectionBuilder.WriteString("\n| {{ .Repository }} | {{ .Alias }}({{ .Name }}) | {{ .Version }} |")
 	requirementsSectionBuilder.WriteString("    {{- else }}")
 	requirementsSectionBuilder.WriteString("\n| {{ .Repository }} | {{ .Name }} | {{ .Version }} |")
 	requirementsSectionBuilder.WriteString("    {{- end }}")
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.Locked }} {{ .LockedVersion }} |{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- end }}")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ end }}")
+
+	// Conditions, tags and import-values of the dependencies get columns when any dependency uses them, linking to the
+	// values controlling them
+	requirementsSectionBuilder.WriteString(`{{ define "chart.requirementsControlsTable" }}`)
+	requirementsSectionBuilder.WriteString("| Repository | Name | Version |{{ if .Requirements.Locked }} Locked Version |{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasToggles }} Enabled |{{ end }}{{ if .Requirements.HasConditions }} Condition |{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasTags }} Tags |{{ end }}{{ if .Requirements.HasImports }} Import Values |{{ end }}\n")
+	requirementsSectionBuilder.WriteString("|------------|------|---------|{{ if .Requirements.Locked }}----------------|{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasToggles }}---------|{{ end }}{{ if .Requirements.HasConditions }}-----------|{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if .Requirements.HasTags }}------|{{ end }}{{ if .Requirements.HasImports }}---------------|{{ end }}")
+	requirementsSectionBuilder.WriteString("  {{- range .Requirements.Dependencies }}")
+	requirementsSectionBuilder.WriteString("\n| {{ .Repository }} | {{ if .Alias }}{{ .Alias }}({{ .Name }}){{ else }}{{ .Name }}{{ end }} | {{ .Version }} |")
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.Locked }} {{ .LockedVersion }} |{{ end }}")
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.HasToggles }} {{ .EnabledByDefault }} |{{ end }}")
+	requirementsSectionBuilder.WriteString(`{{ if $.Requirements.HasConditions }} {{ range $i, $reference := .Conditions }}{{ if $i }}, {{ end }}{{ template "chart.valueReferenceRenderMd" $reference }}{{ end }} |{{ end }}`)
+	requirementsSectionBuilder.WriteString(`{{ if $.Requirements.HasTags }} {{ range $i, $reference := .TagValues }}{{ if $i }}, {{ end }}{{ template "chart.valueReferenceRenderMd" $reference }}{{ end }} |{{ end }}`)
+	requirementsSectionBuilder.WriteString("{{ if $.Requirements.HasImports }} {{ range $i, $import := .Imports }}{{ if $i }}, {{ end }}`{{ $import.Child }}` → `{{ default \".\" $import.Parent }}`{{ end }} |{{ end }}")
 	requirementsSectionBuilder.WriteString("  {{- end }}")
 	requirementsSectionBuilder.WriteString("{{ end }}")
 
@@ -206,10 +511,10 @@ func getRequirementsTableTemplates() string {
 
 func getValuesTableTemplates() string {
 	valuesSectionBuilder := strings.Builder{}
-	valuesSectionBuilder.WriteString(`{{ define "chart.valuesHeader" }}## Values{{ end }}`)
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesHeader" }}## {{ translate "Values" }}{{ end }}`)
 
 	valuesSectionBuilder.WriteString(`{{ define "chart.valueKeyColumnRenderMd" }}`)
-	valuesSectionBuilder.WriteString("{{ .Key }}")
+	valuesSectionBuilder.WriteString(`{{ valueAnchor .Anchor }}{{ .Key }}`)
 	valuesSectionBuilder.WriteString("{{ end }}")
 
 	valuesSectionBuilder.WriteString(`{{ define "chart.valueTypeColumnRenderMd" }}`)
@@ -218,10 +523,32 @@ func getValuesTableTemplates() string {
 
 	valuesSectionBuilder.WriteString(`{{ define "chart.valueDefaultColumnRenderMd" }}`)
 	valuesSectionBuilder.WriteString("{{ if .Default }}{{ .Default }}{{ else }}{{ .AutoDefault }}{{ end }}")
+	valuesSectionBuilder.WriteString(`{{ with .DefaultFrom }} ({{ translate "from" }} {{ . }}){{ end }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueReferenceRenderMd" }}`)
+	valuesSectionBuilder.WriteString("{{ if .Anchor }}[{{ .Key }}]({{ .Path }}#{{ .Anchor }}){{ else }}`{{ .Key }}`{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueSeeAlsoRenderMd" }}`)
+	valuesSectionBuilder.WriteString(`{{ with .See }} ({{ translate "see" }} {{ range $i, $reference := . }}{{ if $i }}, {{ end }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueReferenceRenderMd" $reference }}`)
+	valuesSectionBuilder.WriteString("{{ end }}){{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueRequiredRenderMd" }}`)
+	valuesSectionBuilder.WriteString(`{{ if .Required }}**{{ translate "Required" }}**{{ with .RequiredMessage }} ({{ . | escapeCell }}){{ end }}`)
+	valuesSectionBuilder.WriteString("{{ if or .Description .AutoDescription }} {{ end }}{{ end }}")
 	valuesSectionBuilder.WriteString("{{ end }}")
 
 	valuesSectionBuilder.WriteString(`{{ define "chart.valueDescriptionColumnRenderMd" }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueRequiredRenderMd" . }}`)
 	valuesSectionBuilder.WriteString("{{ if .Description }}{{ .Description }}{{ else }}{{ .AutoDescription }}{{ end }}")
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueSeeAlsoRenderMd" . }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueUsedInColumnRenderMd" }}`)
+	valuesSectionBuilder.WriteString(`{{ range $i, $file := .UsedIn }}{{ if $i }}, {{ end }}[{{ $file }}]({{ $file }}){{ end }}`)
 	valuesSectionBuilder.WriteString("{{ end }}")
 
 	valuesSectionBuilder.WriteString(`{{ define "chart.valuesTable" }}`)
@@ -230,31 +557,43 @@ func getValuesTableTemplates() string {
 	valuesSectionBuilder.WriteString("\n")
 	valuesSectionBuilder.WriteString("\n### {{ .SectionName }}\n")
 	valuesSectionBuilder.WriteString("\n")
-	valuesSectionBuilder.WriteString("| Key | Type | Default | Description |\n")
-	valuesSectionBuilder.WriteString("|-----|------|---------|-------------|\n")
+	valuesSectionBuilder.WriteString("| Key | Type | Default | Description |{{ if $.ValuesUsedInColumn }} Used in |{{ end }}\n")
+	valuesSectionBuilder.WriteString("|-----|------|---------|-------------|{{ if $.ValuesUsedInColumn }}---------|{{ end }}\n")
 	valuesSectionBuilder.WriteString("  {{- range .SectionItems }}")
-	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderMd" . }} | {{ template "chart.valueTypeColumnRenderMd" . }} | {{ template "chart.valueDefaultColumnRenderMd" . }} | {{ template "chart.valueDescriptionColumnRenderMd" . }} |`)
+	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderMd" . }} | {{ template "chart.valueTypeColumnRenderMd" . }} | {{ template "chart.valueDefaultColumnRenderMd" . }} | {{ template "chart.valueDescriptionColumnRenderMd" . }} |{{ if $.ValuesUsedInColumn }} {{ template "chart.valueUsedInColumnRenderMd" . }} |{{ end }}`)
 	valuesSectionBuilder.WriteString("  {{- end }}")
 	valuesSectionBuilder.WriteString("{{- end }}")
 	valuesSectionBuilder.WriteString("{{ if .Sections.DefaultSection.SectionItems}}")
 	valuesSectionBuilder.WriteString("\n")
 	valuesSectionBuilder.WriteString("\n### {{ .Sections.DefaultSection.SectionName }}\n")
 	valuesSectionBuilder.WriteString("\n")
-	valuesSectionBuilder.WriteString("| Key | Type | Default | Description |\n")
-	valuesSectionBuilder.WriteString("|-----|------|---------|-------------|\n")
+	valuesSectionBuilder.WriteString("| Key | Type | Default | Description |{{ if $.ValuesUsedInColumn }} Used in |{{ end }}\n")
+	valuesSectionBuilder.WriteString("|-----|------|---------|-------------|{{ if $.ValuesUsedInColumn }}---------|{{ end }}\n")
 	valuesSectionBuilder.WriteString("  {{- range .Sections.DefaultSection.SectionItems }}")
-	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderMd" . }} | {{ template "chart.valueTypeColumnRenderMd" . }} | {{ template "chart.valueDefaultColumnRenderMd" . }} | {{ template "chart.valueDescriptionColumnRenderMd" . }} |`)
+	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderMd" . }} | {{ template "chart.valueTypeColumnRenderMd" . }} | {{ template "chart.valueDefaultColumnRenderMd" . }} | {{ template "chart.valueDescriptionColumnRenderMd" . }} |{{ if $.ValuesUsedInColumn }} {{ template "chart.valueUsedInColumnRenderMd" . }} |{{ end }}`)
 	valuesSectionBuilder.WriteString("  {{- end }}")
 	valuesSectionBuilder.WriteString("{{ end }}")
 	valuesSectionBuilder.WriteString("{{ else }}")
-	valuesSectionBuilder.WriteString("| Key | Type | Default | Description |\n")
-	valuesSectionBuilder.WriteString("|-----|------|---------|-------------|\n")
+	valuesSectionBuilder.WriteString("| Key | Type | Default | Description |{{ if $.ValuesUsedInColumn }} Used in |{{ end }}\n")
+	valuesSectionBuilder.WriteString("|-----|------|---------|-------------|{{ if $.ValuesUsedInColumn }}---------|{{ end }}\n")
 	valuesSectionBuilder.WriteString("  {{- range .Values }}")
-	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderMd" . }} | {{ template "chart.valueTypeColumnRenderMd" . }} | {{ template "chart.valueDefaultColumnRenderMd" . }} | {{ template "chart.valueDescriptionColumnRenderMd" . }} |`)
+	valuesSectionBuilder.WriteString("\n" + `| {{ template "chart.valueKeyColumnRenderMd" . }} | {{ template "chart.valueTypeColumnRenderMd" . }} | {{ template "chart.valueDefaultColumnRenderMd" . }} | {{ template "chart.valueDescriptionColumnRenderMd" . }} |{{ if $.ValuesUsedInColumn }} {{ template "chart.valueUsedInColumnRenderMd" . }} |{{ end }}`)
 	valuesSectionBuilder.WriteString("  {{- end }}")
 	valuesSectionBuilder.WriteString("{{ end }}")
 	valuesSectionBuilder.WriteString("{{ end }}")
 
+	// Table of contents, linking to the sections and top-level keys of the values
+	valuesSectionBuilder.WriteString(`{{ define "chart.valuesToc" }}`)
+	valuesSectionBuilder.WriteString("{{ range .ValuesToc }}")
+	valuesSectionBuilder.WriteString("{{ if .Name }}")
+	valuesSectionBuilder.WriteString("\n- [{{ .Name }}](#{{ .Anchor }})")
+	valuesSectionBuilder.WriteString("{{ range .Keys }}\n  - {{ if .Anchor }}[`{{ .Key }}`](#{{ .Anchor }}){{ else }}`{{ .Key }}`{{ end }}{{ end }}")
+	valuesSectionBuilder.WriteString("{{ else }}")
+	valuesSectionBuilder.WriteString("{{ range .Keys }}\n- {{ if .Anchor }}[`{{ .Key }}`](#{{ .Anchor }}){{ else }}`{{ .Key }}`{{ end }}{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
 	// TODO: Remove this block and rename `chart.valuesTable` to `chart.valuesTableMd` in the future
 	valuesSectionBuilder.WriteString(`{{ define "chart.valuesTableMd" }}`)
 	valuesSectionBuilder.WriteString(`{{ template "chart.valuesTable" . }}`)
@@ -282,8 +621,29 @@ func getValuesTableTemplates() string {
 	valuesSectionBuilder.WriteString(`{{ template "chart.valueTypeColumnRenderMd" . }}`)
 	valuesSectionBuilder.WriteString("{{ end }}")
 
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueReferenceRenderHtml" }}`)
+	valuesSectionBuilder.WriteString(`{{ if .Anchor }}<a href="{{ .Path }}#{{ .Anchor }}">{{ .Key }}</a>{{ else }}<code>{{ .Key }}</code>{{ end }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueSeeAlsoRenderHtml" }}`)
+	valuesSectionBuilder.WriteString(`{{ with .See }} ({{ translate "see" }} {{ range $i, $reference := . }}{{ if $i }}, {{ end }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueReferenceRenderHtml" $reference }}`)
+	valuesSectionBuilder.WriteString("{{ end }}){{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueRequiredRenderHtml" }}`)
+	valuesSectionBuilder.WriteString(`{{ if .Required }}<strong>{{ translate "Required" }}</strong>{{ with .RequiredMessage }} ({{ . | html }}){{ end }}`)
+	valuesSectionBuilder.WriteString("{{ if or .Description .AutoDescription }} {{ end }}{{ end }}")
+	valuesSectionBuilder.WriteString("{{ end }}")
+
 	valuesSectionBuilder.WriteString(`{{ define "chart.valueDescriptionColumnRenderHtml" }}`)
-	valuesSectionBuilder.WriteString(`{{ template "chart.valueDescriptionColumnRenderMd" . }}`)
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueRequiredRenderHtml" . }}`)
+	valuesSectionBuilder.WriteString("{{ if .Description }}{{ default .Description .HtmlDescription }}{{ else }}{{ default .AutoDescription .HtmlAutoDescription }}{{ end }}")
+	valuesSectionBuilder.WriteString(`{{ template "chart.valueSeeAlsoRenderHtml" . }}`)
+	valuesSectionBuilder.WriteString("{{ end }}")
+
+	valuesSectionBuilder.WriteString(`{{ define "chart.valueUsedInColumnRenderHtml" }}`)
+	valuesSectionBuilder.WriteString(`{{ range $i, $file := .UsedIn }}{{ if $i }}, {{ end }}<a href="{{ $file }}">{{ $file }}</a>{{ end }}`)
 	valuesSectionBuilder.WriteString("{{ end }}")
 
 	valuesSectionBuilder.WriteString(`
@@ -314,6 +674,9 @@ func getValuesTableTemplates() string {
 		<th>Type</th>
 		<th>Default</th>
 		<th>Description</th>
+		{{- if $.ValuesUsedInColumn }}
+		<th>Used in</th>
+		{{- end }}
 	</thead>
 	<tbody>
 	{{- range .SectionItems }}
@@ -322,6 +685,9 @@ func getValuesTableTemplates() string {
 			<td>{{ template "chart.valueTypeColumnRenderHtml" . }}</td>
 			<td>{{ template "chart.valueDefaultColumnRenderHtml" . }}</td>
 			<td>{{ template "chart.valueDescriptionColumnRenderHtml" . }}</td>
+			{{- if $.ValuesUsedInColumn }}
+			<td>{{ template "chart.valueUsedInColumnRenderHtml" . }}</td>
+			{{- end }}
 		</tr>
 	{{- end }}
 	</tbody>
@@ -335,6 +701,9 @@ func getValuesTableTemplates() string {
 		<th>Type</th>
 		<th>Default</th>
 		<th>Description</th>
+		{{- if $.ValuesUsedInColumn }}
+		<th>Used in</th>
+		{{- end }}
 	</thead>
 	<tbody>
 	{{- range .Sections.DefaultSection.SectionItems }}
@@ -343,6 +712,9 @@ func getValuesTableTemplates() string {
 		<td>{{ template "chart.valueTypeColumnRenderHtml" . }}</td>
 		<td>{{ template "chart.valueDefaultColumnRenderHtml" . }}</td>
 		<td>{{ template "chart.valueDescriptionColumnRenderHtml" . }}</td>
+		{{- if $.ValuesUsedInColumn }}
+		<td>{{ template "chart.valueUsedInColumnRenderHtml" . }}</td>
+		{{- end }}
 	</tr>
 	{{- end }}
 	</tbody>
@@ -355,6 +727,9 @@ func getValuesTableTemplates() string {
 		<th>Type</th>
 		<th>Default</th>
 		<th>Description</th>
+		{{- if $.ValuesUsedInColumn }}
+		<th>Used in</th>
+		{{- end }}
 	</thead>
 	<tbody>
 	{{- range .Values }}
@@ -363,6 +738,9 @@ func getValuesTableTemplates() string {
 			<td>{{ template "chart.valueTypeColumnRenderHtml" . }}</td>
 			<td>{{ template "chart.valueDefaultColumnRenderHtml" . }}</td>
 			<td>{{ template "chart.valueDescriptionColumnRenderHtml" . }}</td>
+			{{- if $.ValuesUsedInColumn }}
+			<td>{{ template "chart.valueUsedInColumnRenderHtml" . }}</td>
+			{{- end }}
 		</tr>
 	{{- end }}
 	</tbody>
@@ -386,6 +764,89 @@ func getValuesTableTemplates() string {
 	return valuesSectionBuilder.String()
 }
 
+func getValuesTreeTemplates() string {
+	valuesTreeBuilder := strings.Builder{}
+
+	// Indented tree, as a nested markdown list
+	valuesTreeBuilder.WriteString(`{{ define "chart.valuesTreeNode" }}`)
+	valuesTreeBuilder.WriteString(`{{ repeat (int (mul .Depth 2)) " " }}- `)
+	valuesTreeBuilder.WriteString("{{ if .Children }}**{{ .Name }}**{{ else }}`{{ .Name }}`{{ end }}")
+	valuesTreeBuilder.WriteString(`{{ with .Row }} ({{ template "chart.valueTypeColumnRenderMd" . }}){{ if not $.Children }} {{ template "chart.valueDefaultColumnRenderMd" . }}{{ end }}`)
+	valuesTreeBuilder.WriteString(`{{ if or .Description .AutoDescription }} - {{ template "chart.valueDescriptionColumnRenderMd" . }}{{ end }}{{ end }}`)
+	valuesTreeBuilder.WriteString("{{ range .Children }}\n")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesTreeNode" . }}`)
+	valuesTreeBuilder.WriteString("{{ end }}")
+	valuesTreeBuilder.WriteString("{{ end }}")
+
+	valuesTreeBuilder.WriteString(`{{ define "chart.valuesTree" }}`)
+	valuesTreeBuilder.WriteString("{{ range .ValuesTree.Children }}\n")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesTreeNode" . }}`)
+	valuesTreeBuilder.WriteString("{{ end }}")
+	valuesTreeBuilder.WriteString("{{ end }}")
+
+	valuesTreeBuilder.WriteString(`{{ define "chart.valuesSectionTree" }}`)
+	valuesTreeBuilder.WriteString("{{ if .Values }}")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesHeader" . }}`)
+	valuesTreeBuilder.WriteString("\n")
+	valuesTreeBuilder.WriteString(`{{ template "chart.valuesTree" . }}`)
+	valuesTreeBuilder.WriteString("{{ end }}")
+	valuesTreeBuilder.WriteString("{{ end }}")
+
+	// Collapsible groups, with a <details> element for each object or list, closed by default
+	valuesTreeBuilder.WriteString(`
+{{ define "chart.valuesTreeLeavesHtml" }}
+{{- if .Leaves }}
+<table>
+	<thead>
+		<th>Key</th>
+		<th>Type</th>
+		<th>Default</th>
+		<th>Description</th>
+	</thead>
+	<tbody>
+	{{- range .Leaves }}
+		<tr>
+			<td>{{ .Name }}</td>
+			{{- with .Row }}
+			<td>{{ template "chart.valueTypeColumnRenderHtml" . }}</td>
+			<td>{{ template "chart.valueDefaultColumnRenderHtml" . }}</td>
+			<td>{{ template "chart.valueDescriptionColumnRenderHtml" . }}</td>
+			{{- end }}
+		</tr>
+	{{- end }}
+	</tbody>
+</table>
+{{- end }}
+{{- end }}
+
+{{ define "chart.valuesTreeNodeHtml" }}
+<details>
+<summary><code>{{ .Key }}</code>{{ with .Row }}{{ if or .Description .AutoDescription }} - {{ template "chart.valueDescriptionColumnRenderHtml" . }}{{ end }}{{ end }}</summary>
+{{ template "chart.valuesTreeLeavesHtml" . }}
+{{- range .Groups }}
+{{ template "chart.valuesTreeNodeHtml" . }}
+{{- end }}
+</details>
+{{- end }}
+
+{{ define "chart.valuesTreeHtml" }}
+{{- template "chart.valuesTreeLeavesHtml" .ValuesTree }}
+{{- range .ValuesTree.Groups }}
+{{ template "chart.valuesTreeNodeHtml" . }}
+{{- end }}
+{{ end }}
+
+{{ define "chart.valuesSectionTreeHtml" }}
+{{- if .Values }}
+{{ template "chart.valuesHeader" . }}
+{{ template "chart.valuesTreeHtml" . }}
+{{- end }}
+{{ end }}
+`)
+
+	return valuesTreeBuilder.String()
+}
+
 func getHelmDocsVersionTemplates() string {
 	versionSectionBuilder := strings.Builder{}
 	versionSectionBuilder.WriteString(`{{ define "helm-docs.version" }}{{ if .HelmDocsVersion }}{{ .HelmDocsVersion }}{{ end }}{{ end }}`)
@@ -399,7 +860,7 @@ func getHelmDocsVersionTemplates() string {
 	return versionSectionBuilder.String()
 }
 
-func getDocumentationTemplate(chartDirectory string, chartSearchRoot string, templateFiles []string) (string, error) {
+func getDocumentationTemplate(chartDirectory string, chartSearchRoot string, templateFiles []string, format string) (string, error) {
 	templateFilesForChart := make([]string, 0)
 
 	var templateNotFound bool
@@ -436,23 +897,32 @@ func getDocumentationTemplate(chartDirectory string, chartSearchRoot string, tem
 	}
 
 	if templateNotFound {
-		allTemplateContents = append(allTemplateContents, []byte(defaultDocumentationTemplate)...)
+		if format == AsciiDocOutputFormat {
+			allTemplateContents = append(allTemplateContents, []byte(defaultAsciiDocDocumentationTemplate)...)
+		} else {
+			allTemplateContents = append(allTemplateContents, []byte(defaultDocumentationTemplate)...)
+		}
 	}
 
 	return string(allTemplateContents), nil
 }
 
-func getDocumentationTemplates(chartDirectory string, chartSearchRoot string, templateFiles []string, badgeStyle string) ([]string, error) {
-	documentationTemplate, err := getDocumentationTemplate(chartDirectory, chartSearchRoot, templateFiles)
+func getDocumentationTemplates(chartDirectory string, chartSearchRoot string, templateFiles []string, badgeStyle string, format string) ([]string, error) {
+	documentationTemplate, err := getDocumentationTemplate(chartDirectory, chartSearchRoot, templateFiles, format)
 
 	if err != nil {
 		log.Errorf("Failed to read documentation template for chart %s: %s", chartDirectory, err)
 		return nil, err
 	}
 
+	if format == AsciiDocOutputFormat {
+		return append(getAsciiDocTemplates(badgeStyle), documentationTemplate), nil
+	}
+
 	return []string{
 		getNameTemplate(),
 		getHeaderTemplate(),
+		getIconTemplates(),
 		getDeprecatedTemplate(),
 		getAppVersionTemplate(badgeStyle),
 		getBadgesTemplates(),
@@ -460,19 +930,29 @@ func getDocumentationTemplates(chartDirectory string, chartSearchRoot string, te
 		getVersionTemplates(badgeStyle),
 		getTypeTemplate(badgeStyle),
 		getSourceLinkTemplates(),
+		getArtifactHubTemplates(),
+		getCustomResourceDefinitionsTemplates(),
+		getNamedTemplatesTemplates(),
+		getRequiredValuesTemplates(),
+		getRenderedResourcesTemplates(),
+		getRBACTemplates(),
+		getKubeCompatibilityTemplates(),
 		getRequirementsTableTemplates(),
 		getValuesTableTemplates(),
+		getValuesTreeTemplates(),
 		getHomepageTemplate(),
+		getKeywordsTemplates(),
 		getMaintainersTemplate(),
 		getHelmDocsVersionTemplates(),
 		documentationTemplate,
 	}, nil
 }
 
-func newChartDocumentationTemplate(chartDocumentationInfo helm.ChartDocumentationInfo, chartSearchRoot string, templateFiles []string, badgeStyle string) (*template.Template, error) {
+func newChartDocumentationTemplate(chartDocumentationInfo helm.ChartDocumentationInfo, chartSearchRoot string, templateFiles []string, badgeStyle string, t *translator, format string) (*template.Template, error) {
 	documentationTemplate := template.New(chartDocumentationInfo.ChartDirectory)
 	documentationTemplate.Funcs(util.FuncMap())
-	goTemplateList, err := getDocumentationTemplates(chartDocumentationInfo.ChartDirectory, chartSearchRoot, templateFiles, badgeStyle)
+	documentationTemplate.Funcs(template.FuncMap{"translate": t.translate, "escapeCell": escapeTableCell(format), "valueAnchor": valueAnchorFunc(format)})
+	goTemplateList, err := getDocumentationTemplates(chartDocumentationInfo.ChartDirectory, chartSearchRoot, templateFiles, badgeStyle, format)
 
 	if err != nil {
 		return nil, err
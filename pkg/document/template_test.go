@@ -1,14 +1,20 @@
 package document
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
 )
 
 func TestGetDocumentationTemplate(t *testing.T) {
-	tpl, err := getDocumentationTemplate(".", ".", []string{"testdata/nonexistent.md.gotmpl"})
+	tpl, err := getDocumentationTemplate(".", ".", []string{"testdata/nonexistent.md.gotmpl"}, MarkdownOutputFormat)
 
 	require.NoError(t, err)
 	assert.Equal(t, defaultDocumentationTemplate, tpl)
@@ -19,10 +25,226 @@ func TestGetDocumentationTemplate_LoadDefaultOnNotFound(t *testing.T) {
 		"testdata/README.md.gotmpl",
 		"testdata/nonexistent.md.gotmpl",
 		"testdata/README2.md.gotmpl",
-	})
+	}, MarkdownOutputFormat)
 
 	const expected = "hello\nhello again\n" + defaultDocumentationTemplate
 
 	require.NoError(t, err)
 	assert.Equal(t, expected, tpl)
 }
+
+// getTemplateTestChartInfo returns a chart with the given values file for the template tests to fill in.
+func getTemplateTestChartInfo(t *testing.T, values string) helm.ChartDocumentationInfo {
+	return helm.ChartDocumentationInfo{
+		ChartDirectory:          t.TempDir(),
+		ChartMeta:               helm.ChartMeta{ApiVersion: "v2", Name: "my-chart", Version: "1.0.0"},
+		ChartValues:             &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{parseYamlValues(values)}},
+		ChartValuesDescriptions: make(map[string]helm.ChartValueDescription),
+	}
+}
+
+// renderTestTemplate renders a template for a chart in the given output format.
+func renderTestTemplate(t *testing.T, info helm.ChartDocumentationInfo, chartTemplate string, format string) string {
+	templateFile := filepath.Join(info.ChartDirectory, "README.gotmpl")
+	require.NoError(t, os.WriteFile(templateFile, []byte(chartTemplate), 0644))
+
+	tpl, err := newChartDocumentationTemplate(info, info.ChartDirectory, []string{"README.gotmpl"}, "flat-square", &translator{}, format)
+	require.NoError(t, err)
+
+	data, err := getChartTemplateData(info, "", nil, true, &translator{})
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	require.NoError(t, tpl.Execute(&output, data))
+	return output.String()
+}
+
+func TestIconAndKeywordsTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.Icon = "https://example.com/icon.png"
+	info.Keywords = []string{"web", "proxy"}
+
+	render := func(info helm.ChartDocumentationInfo, format string) string {
+		return renderTestTemplate(t, info, `{{ template "chart.iconHeader" . }}{{ template "chart.keywordsLine" . }}`, format)
+	}
+
+	assert.Equal(t, "# <img src=\"https://example.com/icon.png\" alt=\"\" height=\"32\"> my-chart\n**Keywords:** `web` `proxy`", render(info, MarkdownOutputFormat))
+	assert.Equal(t, "= image:https://example.com/icon.png[height=32] my-chart\n*Keywords:* `+web+` `+proxy+`", render(info, AsciiDocOutputFormat))
+
+	info.Icon = ""
+	info.Keywords = nil
+	assert.Equal(t, "# my-chart\n", render(info, MarkdownOutputFormat))
+}
+
+func TestArtifactHubTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.ArtifactHub = helm.ArtifactHubAnnotations{
+		Changes: []helm.ArtifactHubChange{
+			{Kind: "fixed", Description: "Fix the service port", Links: []helm.ArtifactHubLink{{Name: "Issue", URL: "https://example.com/issues/1"}}},
+			{Description: "Bump the image"},
+		},
+		Images: []helm.ArtifactHubImage{{Name: "app", Image: "example.com/app:1.0.0", Platforms: []string{"linux/amd64", "linux/arm64"}}},
+		Links:  []helm.ArtifactHubLink{{Name: "Support", URL: "https://example.com/support"}},
+	}
+
+	const chartTemplate = `{{ template "chart.changelogSection" . }}
+
+{{ template "chart.imagesSection" . }}
+
+{{ template "chart.linksSection" . }}`
+
+	assert.Equal(t, "## Changelog\n\n### 1.0.0\n\n"+
+		"* **Fixed:** Fix the service port ([Issue](https://example.com/issues/1))\n"+
+		"* Bump the image\n\n"+
+		"## Images\n\n"+
+		"| Name | Image | Platforms |\n"+
+		"|------|-------|-----------|\n"+
+		"| app | `example.com/app:1.0.0` | linux/amd64, linux/arm64 |\n\n"+
+		"## Links\n\n"+
+		"* [Support](https://example.com/support)", renderTestTemplate(t, info, chartTemplate, MarkdownOutputFormat))
+
+	info.ArtifactHub = helm.ArtifactHubAnnotations{}
+	assert.Equal(t, "\n\n\n\n", renderTestTemplate(t, info, chartTemplate, MarkdownOutputFormat))
+}
+
+func TestCustomResourceDefinitionsTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.CustomResourceDefinitions = []helm.CustomResourceDefinition{{
+		File:  "crds/widgets.yaml",
+		Name:  "widgets.example.com",
+		Group: "example.com",
+		Kind:  "Widget",
+		Scope: "Namespaced",
+		Versions: []helm.CustomResourceDefinitionVersion{
+			{Name: "v1", Properties: []helm.CustomResourceProperty{
+				{Path: "spec", Type: "object", Description: "Desired state"},
+				{Path: "spec.size", Type: "integer", Description: "Number of parts | pieces", Required: true},
+			}},
+			{Name: "v1beta1", Deprecated: true, DeprecationWarning: "use v1"},
+		},
+		Examples: []helm.ArtifactHubCRDExample{{Yaml: "kind: Widget\n"}},
+	}}
+
+	assert.Equal(t, "## Custom Resource Definitions\n\n"+
+		"### Widget\n\n"+
+		"`widgets.example.com`, Namespaced, defined in [crds/widgets.yaml](crds/widgets.yaml)\n\n"+
+		"#### example.com/v1\n\n"+
+		"| Field | Type | Required | Description |\n"+
+		"|-------|------|----------|-------------|\n"+
+		"| `spec` | object | false | Desired state |\n"+
+		"| `spec.size` | integer | true | Number of parts \\| pieces |\n\n"+
+		"#### example.com/v1beta1\n\n"+
+		"> **Deprecated**: use v1\n\n"+
+		"#### Example\n\n"+
+		"```yaml\nkind: Widget\n```", renderTestTemplate(t, info, `{{ template "chart.crdsSection" . }}`, MarkdownOutputFormat))
+}
+
+func TestNamedTemplatesTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.NamedTemplates = []helm.NamedTemplate{
+		{
+			Name:        "lib.labels",
+			File:        "templates/_helpers.tpl",
+			Line:        12,
+			Description: "Common labels.",
+			Parameters:  []helm.NamedTemplateParameter{{Name: "component", Type: "string", Description: "Name of the component | tier"}},
+			Example:     "{{ include \"lib.labels\" . }}",
+		},
+		{Name: "lib.name", File: "templates/_helpers.tpl", Line: 2},
+	}
+
+	assert.Equal(t, "## Named Templates\n\n"+
+		"### `lib.labels`\n\n"+
+		"Common labels.\n\n"+
+		"Defined in [templates/_helpers.tpl](templates/_helpers.tpl#L12)\n\n"+
+		"| Parameter | Type | Description |\n"+
+		"|-----------|------|-------------|\n"+
+		"| `component` | string | Name of the component \\| tier |\n\n"+
+		"```\n{{ include \"lib.labels\" . }}\n```\n\n"+
+		"### `lib.name`\n\n"+
+		"Defined in [templates/_helpers.tpl](templates/_helpers.tpl#L2)", renderTestTemplate(t, info, `{{ template "chart.namedTemplatesSection" . }}`, MarkdownOutputFormat))
+}
+
+func TestResourcesTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.RenderedResources = []helm.RenderedResource{
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "release-name-app", Namespaced: true, File: "templates/deployment.yaml"},
+		{APIVersion: "monitoring.coreos.com/v1", Kind: "ServiceMonitor", Name: "release-name-app", Namespace: "monitoring", Namespaced: true, File: "templates/servicemonitor.yaml"},
+		{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "release-name-reader", File: "templates/rbac.yaml"},
+		{APIVersion: "batch/v1", Kind: "Job", Name: "release-name-migrate", Namespaced: true, Hook: "pre-install", File: "templates/job.yaml"},
+	}
+
+	assert.Equal(t, "## Resources Created\n\n"+
+		"| Kind | API Version | Name | Scope | Template |\n"+
+		"|------|-------------|------|-------|----------|\n"+
+		"| Deployment | apps/v1 | `release-name-app` | Namespaced | [templates/deployment.yaml](templates/deployment.yaml) |\n"+
+		"| ServiceMonitor | monitoring.coreos.com/v1 | `release-name-app` | Namespaced (`monitoring`) | [templates/servicemonitor.yaml](templates/servicemonitor.yaml) |\n"+
+		"| ClusterRole | rbac.authorization.k8s.io/v1 | `release-name-reader` | Cluster | [templates/rbac.yaml](templates/rbac.yaml) |\n"+
+		"| Job (hook pre-install) | batch/v1 | `release-name-migrate` | Namespaced | [templates/job.yaml](templates/job.yaml) |", renderTestTemplate(t, info, `{{ template "chart.resourcesSection" . }}`, MarkdownOutputFormat))
+}
+
+func TestRBACTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.RBAC = helm.ChartRBAC{
+		Roles: []helm.RBACRole{
+			{
+				Kind: "ClusterRole",
+				Name: "release-name-reader",
+				Rules: []helm.RBACRule{
+					{APIGroups: []string{"", "apps"}, Resources: []string{"pods", "deployments"}, Verbs: []string{"get", "list"}},
+					{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"app-config"}, Verbs: []string{"update"}},
+					{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+				},
+				File:    "templates/rbac.yaml",
+				Toggles: []string{"rbac.create"},
+			},
+			{Kind: "Role", Name: "release-name-empty", File: "templates/rbac.yaml"},
+		},
+		Bindings: []helm.RBACBinding{
+			{
+				Kind:     "ClusterRoleBinding",
+				Name:     "release-name-reader",
+				RoleRef:  helm.RBACRoleRef{Kind: "ClusterRole", Name: "release-name-reader"},
+				Subjects: []helm.RBACSubject{{Kind: "ServiceAccount", Name: "release-name", Namespace: "default"}, {Kind: "Group", Name: "auditors"}},
+				File:     "templates/rbac.yaml",
+				Toggles:  []string{"rbac.create"},
+			},
+		},
+	}
+
+	assert.Equal(t, "## RBAC Permissions\n\n"+
+		"| Role | API Groups | Resources | Verbs |\n"+
+		"|------|------------|-----------|-------|\n"+
+		"| ClusterRole `release-name-reader` (toggled by `rbac.create`) | core, `apps` | `pods`, `deployments` | `get`, `list` |\n"+
+		"| ClusterRole `release-name-reader` (toggled by `rbac.create`) | core | `configmaps` (`app-config`) | `update` |\n"+
+		"| ClusterRole `release-name-reader` (toggled by `rbac.create`) |  | `/metrics` | `get` |\n"+
+		"| Role `release-name-empty` |  |  |  |\n\n"+
+		"| Binding | Role | Subjects |\n"+
+		"|---------|------|----------|\n"+
+		"| ClusterRoleBinding `release-name-reader` (toggled by `rbac.create`) | ClusterRole `release-name-reader` | ServiceAccount `release-name` (in `default`), Group `auditors` |", renderTestTemplate(t, info, `{{ template "chart.rbacSection" . }}`, MarkdownOutputFormat))
+}
+
+func TestKubeCompatibilityTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, "{}")
+	info.KubeVersion = ">=1.24.3-0"
+	cronJob := helm.KubernetesAPIChange{
+		KubernetesAPIUsage: helm.KubernetesAPIUsage{APIVersion: "batch/v1beta1", Kind: "CronJob", File: "templates/cronjob.yaml", Line: 1},
+		Replacement:        "batch/v1",
+	}
+	podSecurityPolicy := helm.KubernetesAPIChange{
+		KubernetesAPIUsage: helm.KubernetesAPIUsage{APIVersion: "policy/v1beta1", Kind: "PodSecurityPolicy", File: "templates/psp.yaml", Line: 1},
+	}
+	info.KubernetesCompatibility = []helm.KubernetesVersionCompatibility{
+		{Version: "1.23", DeprecatedAPIs: []helm.KubernetesAPIChange{cronJob, podSecurityPolicy}},
+		{Version: "1.24", Supported: true, Partial: true, DeprecatedAPIs: []helm.KubernetesAPIChange{cronJob, podSecurityPolicy}},
+		{Version: "1.25", Supported: true, RemovedAPIs: []helm.KubernetesAPIChange{cronJob, podSecurityPolicy}},
+	}
+
+	assert.Equal(t, "## Kubernetes Compatibility\n\n"+
+		"Kubernetes: `>=1.24.3-0`\n\n"+
+		"| Kubernetes | Supported | Removed APIs | Deprecated APIs |\n"+
+		"|------------|-----------|--------------|-----------------|\n"+
+		"| 1.23 | No |  | `batch/v1beta1` CronJob (use `batch/v1`), `policy/v1beta1` PodSecurityPolicy |\n"+
+		"| 1.24 | Partially |  | `batch/v1beta1` CronJob (use `batch/v1`), `policy/v1beta1` PodSecurityPolicy |\n"+
+		"| 1.25 | Yes | `batch/v1beta1` CronJob (use `batch/v1`), `policy/v1beta1` PodSecurityPolicy |  |", renderTestTemplate(t, info, `{{ template "chart.kubeCompatibilitySection" . }}`, MarkdownOutputFormat))
+}
@@ -0,0 +1,143 @@
+package document
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/util"
+)
+
+// translator translates the section names and built-in headings of the documentation of a chart into a language,
+// keeping track of those strings it has no translation for. The zero value renders the default language.
+type translator struct {
+	language         string
+	catalogue        map[string]string
+	missing          map[string]bool
+	untranslatedKeys []string
+}
+
+func newTranslator(chartDirectory string, chartSearchRoot string, language string) (*translator, error) {
+	t := &translator{
+		language:  language,
+		catalogue: make(map[string]string),
+		missing:   make(map[string]bool),
+	}
+
+	if language == "" {
+		return t, nil
+	}
+
+	translationsFile := getTranslationsFilePath(chartDirectory, chartSearchRoot, viper.GetString("translations-file"))
+	if translationsFile == "" {
+		return t, nil
+	}
+
+	translationsFileContents, err := os.ReadFile(translationsFile)
+	if os.IsNotExist(err) {
+		log.Debugf("Did not find translations file %s for chart %s", translationsFile, chartDirectory)
+		return t, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cataloguesByLanguage := make(map[string]map[string]string)
+	if err := yaml.Unmarshal(translationsFileContents, &cataloguesByLanguage); err != nil {
+		return nil, fmt.Errorf("failed to parse translations file %s: %w", translationsFile, err)
+	}
+
+	if catalogue, ok := cataloguesByLanguage[language]; ok {
+		t.catalogue = catalogue
+	}
+
+	return t, nil
+}
+
+// getTranslationsFilePath resolves the translations file the same way as template files, relative to the chart search
+// root when given as a relative path, or to each chart directory when given as a file name.
+func getTranslationsFilePath(chartDirectory string, chartSearchRoot string, translationsFile string) string {
+	if translationsFile == "" {
+		return ""
+	}
+
+	if util.IsRelativePath(translationsFile) {
+		return filepath.Join(chartSearchRoot, translationsFile)
+	}
+
+	if util.IsBaseFilename(translationsFile) {
+		return filepath.Join(chartDirectory, translationsFile)
+	}
+
+	return translationsFile
+}
+
+func (t *translator) translate(text string) string {
+	if t.language == "" || text == "" {
+		return text
+	}
+
+	if translation, ok := t.catalogue[text]; ok {
+		return translation
+	}
+
+	t.missing[text] = true
+	return text
+}
+
+// localizeValueRows replaces the descriptions of the value rows with their translation into the language of the
+// translator, taken from the values documentation file of that language or from "# --[lang]" comments. The keys of
+// documented rows without a translation are recorded for reportMissingTranslations.
+func (t *translator) localizeValueRows(valueRows []valueRow, sidecarTranslations map[string]string) {
+	if t.language == "" {
+		return
+	}
+
+	for i, row := range valueRows {
+		translation, ok := sidecarTranslations[row.Key]
+		if !ok {
+			translation, ok = row.Translations[t.language]
+		}
+
+		if ok {
+			valueRows[i].Description = translation
+		} else if row.Description != "" || row.AutoDescription != "" {
+			t.untranslatedKeys = append(t.untranslatedKeys, row.Key)
+		}
+	}
+}
+
+func (t *translator) localizeSections(sectionedValueRows *sections) {
+	sectionedValueRows.DefaultSection.SectionName = t.translate(sectionedValueRows.DefaultSection.SectionName)
+
+	for i := range sectionedValueRows.Sections {
+		sectionedValueRows.Sections[i].SectionName = t.translate(sectionedValueRows.Sections[i].SectionName)
+	}
+}
+
+func (t *translator) reportMissingTranslations(chartDirectory string) {
+	if t.language == "" {
+		return
+	}
+
+	missingStrings := make([]string, 0, len(t.missing))
+	for text := range t.missing {
+		missingStrings = append(missingStrings, text)
+	}
+	sort.Strings(missingStrings)
+
+	if len(t.untranslatedKeys) > 0 {
+		log.Warnf("Chart %s is missing %s translations for values: %s", chartDirectory, t.language, strings.Join(t.untranslatedKeys, ", "))
+	}
+
+	if len(missingStrings) > 0 {
+		log.Warnf("Chart %s is missing %s translations for: %s", chartDirectory, t.language, strings.Join(missingStrings, ", "))
+	}
+}
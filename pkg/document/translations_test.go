@@ -0,0 +1,71 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestLocalizeValueRows(t *testing.T) {
+	helmValues := parseYamlValues(`
+# -- The image
+# --[de] Das Image
+image: nginx
+
+# -- Number of replicas
+# to run
+# --[de] Anzahl der
+# Replikas
+# @section -- Scaling
+replicas: 1
+
+# -- Annotations
+annotations: {}
+
+untranslated: true
+	`)
+
+	valuesRows, err := getSortedValuesTableRows(helmValues, make(map[string]helm.ChartValueDescription))
+	assert.Nil(t, err)
+	assert.Len(t, valuesRows, 4)
+
+	tr := &translator{language: "de", missing: make(map[string]bool)}
+	tr.localizeValueRows(valuesRows, map[string]string{"annotations": "Anmerkungen"})
+
+	assert.Equal(t, "annotations", valuesRows[0].Key)
+	assert.Equal(t, "Anmerkungen", valuesRows[0].Description)
+
+	assert.Equal(t, "image", valuesRows[1].Key)
+	assert.Equal(t, "The image", valuesRows[1].AutoDescription)
+	assert.Equal(t, "Das Image", valuesRows[1].Description)
+
+	assert.Equal(t, "replicas", valuesRows[2].Key)
+	assert.Equal(t, "Number of replicas to run", valuesRows[2].AutoDescription)
+	assert.Equal(t, "Anzahl der Replikas", valuesRows[2].Description)
+	assert.Equal(t, "Scaling", valuesRows[2].Section)
+
+	assert.Equal(t, "untranslated", valuesRows[3].Key)
+	assert.Equal(t, "", valuesRows[3].Description)
+
+	assert.Empty(t, tr.untranslatedKeys)
+}
+
+func TestLocalizeSections(t *testing.T) {
+	tr := &translator{
+		language:  "de",
+		catalogue: map[string]string{"Scaling": "Skalierung"},
+		missing:   make(map[string]bool),
+	}
+
+	sectionedValueRows := getSectionedValueRows([]valueRow{
+		{Key: "replicas", Section: "Scaling"},
+		{Key: "image"},
+	})
+	tr.localizeSections(&sectionedValueRows)
+
+	assert.Equal(t, "Skalierung", sectionedValueRows.Sections[0].SectionName)
+	assert.Equal(t, "Other Values", sectionedValueRows.DefaultSection.SectionName)
+	assert.Equal(t, map[string]bool{"Other Values": true}, tr.missing)
+}
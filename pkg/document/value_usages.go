@@ -0,0 +1,32 @@
+package document
+
+import (
+	"sort"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+// setValueRowsUsages sets the templates each value is used in, sorted by path. With and range blocks only use the values
+// containing them, the values nested in them are used by the references in the block.
+func setValueRowsUsages(valueRows []valueRow, usages []helm.ValueUsage) {
+	usageSegments := make([][]string, len(usages))
+	for i, usage := range usages {
+		usageSegments[i] = helm.SplitValueKey(usage.Key)
+	}
+
+	for i := range valueRows {
+		keySegments := helm.SplitValueKey(valueRows[i].Key)
+		files := make(map[string]bool)
+		for j, usage := range usages {
+			if usage.UsesValue(keySegments, usageSegments[j]) {
+				files[usage.File] = true
+			}
+		}
+
+		valueRows[i].UsedIn = nil
+		for file := range files {
+			valueRows[i].UsedIn = append(valueRows[i].UsedIn, file)
+		}
+		sort.Strings(valueRows[i].UsedIn)
+	}
+}
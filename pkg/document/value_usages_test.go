@@ -0,0 +1,64 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestSetValueRowsUsages(t *testing.T) {
+	rows := []valueRow{
+		{Key: "image.repository"},
+		{Key: "image.tag"},
+		{Key: "env.LOG_LEVEL.text"},
+		{Key: "hosts[0].name"},
+		{Key: "resources"},
+		{Key: `annotations."example.com/name"`},
+		{Key: "unused"},
+	}
+
+	setValueRowsUsages(rows, []helm.ValueUsage{
+		{Key: "image", File: "templates/job.yaml"},
+		{Key: "image.tag", File: "templates/deployment.yaml"},
+		{Key: "env[].text", File: "templates/deployment.yaml"},
+		{Key: "hosts[].name", File: "templates/ingress.yaml"},
+		{Key: "hosts[1].name", File: "templates/service.yaml"},
+		{Key: "hosts", File: "templates/service.yaml", Block: true},
+		{Key: "resources.limits.cpu", File: "templates/deployment.yaml"},
+		{Key: `annotations."example.com/name"`, File: "templates/deployment.yaml"},
+		{Key: "unusedOther", File: "templates/deployment.yaml"},
+	})
+
+	assert.Equal(t, []string{"templates/job.yaml"}, rows[0].UsedIn)
+	assert.Equal(t, []string{"templates/deployment.yaml", "templates/job.yaml"}, rows[1].UsedIn)
+	assert.Equal(t, []string{"templates/deployment.yaml"}, rows[2].UsedIn)
+	assert.Equal(t, []string{"templates/ingress.yaml"}, rows[3].UsedIn)
+	assert.Equal(t, []string{"templates/deployment.yaml"}, rows[4].UsedIn)
+	assert.Equal(t, []string{"templates/deployment.yaml"}, rows[5].UsedIn)
+	assert.Empty(t, rows[6].UsedIn)
+}
+
+func TestValuesUsedInColumn(t *testing.T) {
+	viper.Set("values-used-in-column", true)
+	defer viper.Reset()
+
+	info := getTemplateTestChartInfo(t, `
+# -- The image
+image: nginx
+
+# -- Number of replicas
+replicas: 1
+	`)
+	info.ValueUsages = []helm.ValueUsage{
+		{Key: "image", File: "templates/deployment.yaml", Line: 3},
+		{Key: "image", File: "templates/job.yaml", Line: 8},
+	}
+
+	assert.Equal(t, "| Key | Type | Default | Description | Used in |\n"+
+		"|-----|------|---------|-------------|---------|\n"+
+		"| image | string | `\"nginx\"` | The image | [templates/deployment.yaml](templates/deployment.yaml), [templates/job.yaml](templates/job.yaml) |\n"+
+		"| replicas | int | `1` | Number of replicas |  |", renderTestTemplate(t, info, `{{ template "chart.valuesTable" . }}`, MarkdownOutputFormat))
+}
@@ -98,18 +98,75 @@ func parseNilValueType(key string, description helm.ChartValueDescription, autoD
 		section = autoDescription.Section
 	}
 
+	deprecated, deprecationMessage := getDeprecation(description, autoDescription)
+
 	return valueRow{
-		Key:             key,
-		Type:            t,
-		NotationType:    autoDescription.NotationType,
-		AutoDefault:     autoDescription.Default,
-		Default:         description.Default,
-		AutoDescription: autoDescription.Description,
-		Description:     description.Description,
-		Section:         section,
-		Column:          column,
-		LineNumber:      lineNumber,
+		Key:                key,
+		Type:               t,
+		NotationType:       autoDescription.NotationType,
+		AutoDefault:        autoDescription.Default,
+		Default:            description.Default,
+		AutoDescription:    autoDescription.Description,
+		Description:        description.Description,
+		Section:            section,
+		Deprecated:         deprecated,
+		DeprecationMessage: deprecationMessage,
+		Column:             column,
+		LineNumber:         lineNumber,
+		Translations:       getTranslations(description, autoDescription),
+		See:                getValueReferences(description, autoDescription),
+		Example:            getExample(description, autoDescription),
+	}
+}
+
+func getDeprecation(description helm.ChartValueDescription, autoDescription helm.ChartValueDescription) (bool, string) {
+	if description.Deprecated {
+		return true, description.DeprecationMessage
 	}
+
+	return autoDescription.Deprecated, autoDescription.DeprecationMessage
+}
+
+func getValueReferences(description helm.ChartValueDescription, autoDescription helm.ChartValueDescription) []valueReference {
+	keys := description.See
+	if len(keys) == 0 {
+		keys = autoDescription.See
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	references := make([]valueReference, 0, len(keys))
+	for _, key := range keys {
+		references = append(references, valueReference{Key: key})
+	}
+
+	return references
+}
+
+func getExample(description helm.ChartValueDescription, autoDescription helm.ChartValueDescription) string {
+	if description.Example != "" {
+		return description.Example
+	}
+
+	return autoDescription.Example
+}
+
+func getTranslations(description helm.ChartValueDescription, autoDescription helm.ChartValueDescription) map[string]string {
+	if len(description.Translations) == 0 {
+		return autoDescription.Translations
+	}
+
+	translations := make(map[string]string, len(description.Translations)+len(autoDescription.Translations))
+	for language, translation := range autoDescription.Translations {
+		translations[language] = translation
+	}
+	for language, translation := range description.Translations {
+		translations[language] = translation
+	}
+
+	return translations
 }
 
 func jsonMarshalNoEscape(key string, value interface{}) (string, error) {
@@ -191,17 +248,24 @@ func createValueRow(
 		section = autoDescription.Section
 	}
 
+	deprecated, deprecationMessage := getDeprecation(description, autoDescription)
+
 	return valueRow{
-		Key:             key,
-		Type:            defaultType,
-		NotationType:    notationType,
-		AutoDefault:     autoDescription.Default,
-		Default:         defaultValue,
-		AutoDescription: autoDescription.Description,
-		Description:     description.Description,
-		Section:         section,
-		Column:          column,
-		LineNumber:      lineNumber,
+		Key:                key,
+		Type:               defaultType,
+		NotationType:       notationType,
+		AutoDefault:        autoDescription.Default,
+		Default:            defaultValue,
+		AutoDescription:    autoDescription.Description,
+		Description:        description.Description,
+		Section:            section,
+		Deprecated:         deprecated,
+		DeprecationMessage: deprecationMessage,
+		Column:             column,
+		LineNumber:         lineNumber,
+		Translations:       getTranslations(description, autoDescription),
+		See:                getValueReferences(description, autoDescription),
+		Example:            getExample(description, autoDescription),
 	}, nil
 }
 
@@ -0,0 +1,99 @@
+package document
+
+import (
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+// valueTreeNode is a node of the tree of values, with a node for every object or list of the values file containing
+// documented values, and a node for every value row. Nodes of objects and lists without a row of their own only group
+// the rows underneath them.
+type valueTreeNode struct {
+	// Name is the last segment of the node's key, e.g. tag for image.tag and [0] for hosts[0]
+	Name     string
+	Key      string
+	Depth    int
+	Row      *valueRow
+	Children []*valueTreeNode
+}
+
+// Leaves returns the children of the node which don't have children of their own.
+func (n *valueTreeNode) Leaves() []*valueTreeNode {
+	leaves := make([]*valueTreeNode, 0, len(n.Children))
+	for _, child := range n.Children {
+		if len(child.Children) == 0 {
+			leaves = append(leaves, child)
+		}
+	}
+
+	return leaves
+}
+
+// Groups returns the children of the node which have children of their own.
+func (n *valueTreeNode) Groups() []*valueTreeNode {
+	groups := make([]*valueTreeNode, 0, len(n.Children))
+	for _, child := range n.Children {
+		if len(child.Children) > 0 {
+			groups = append(groups, child)
+		}
+	}
+
+	return groups
+}
+
+func getValueRowDescription(row valueRow) string {
+	if row.Description != "" {
+		return row.Description
+	}
+
+	return row.AutoDescription
+}
+
+// setValueRowsHierarchy sets the key and description of the parent of each value row, as well as its depth. The parent
+// description is that of the closest documented object the value is nested in.
+func setValueRowsHierarchy(valueRows []valueRow) {
+	descriptionsByKey := make(map[string]string, len(valueRows))
+	for _, row := range valueRows {
+		descriptionsByKey[row.Key] = getValueRowDescription(row)
+	}
+
+	for i, row := range valueRows {
+		segments := helm.SplitValueKey(row.Key)
+		valueRows[i].Depth = len(segments) - 1
+		valueRows[i].ParentKey = helm.JoinValueKey(segments[:len(segments)-1])
+
+		for parent := len(segments) - 1; parent > 0; parent-- {
+			if description := descriptionsByKey[helm.JoinValueKey(segments[:parent])]; description != "" {
+				valueRows[i].ParentDescription = description
+				break
+			}
+		}
+	}
+}
+
+// getValuesTree returns the root of the tree of the given value rows, whose children are the top-level keys of the
+// values. Children keep the order of the value rows.
+func getValuesTree(valueRows []valueRow) *valueTreeNode {
+	root := &valueTreeNode{Depth: -1}
+	nodesByKey := map[string]*valueTreeNode{"": root}
+
+	for i := range valueRows {
+		segments := helm.SplitValueKey(valueRows[i].Key)
+		parent := root
+
+		for depth := range segments {
+			key := helm.JoinValueKey(segments[:depth+1])
+			node, ok := nodesByKey[key]
+			if !ok {
+				node = &valueTreeNode{Name: segments[depth], Key: key, Depth: depth}
+				nodesByKey[key] = node
+				parent.Children = append(parent.Children, node)
+			}
+
+			parent = node
+		}
+
+		parent.Row = &valueRows[i]
+	}
+
+	return root
+}
@@ -0,0 +1,105 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestValuesTree(t *testing.T) {
+	helmValues := parseYamlValues(`
+replicas: 1
+
+controller:
+  # -- The image
+  image:
+    # -- The repository
+    repository: nginx
+    # -- The tag
+    tag: latest
+  hosts:
+    - example.com
+	`)
+
+	valueRows, err := getSortedValuesTableRows(helmValues, make(map[string]helm.ChartValueDescription))
+	require.NoError(t, err)
+	setValueRowsHierarchy(valueRows)
+	require.Len(t, valueRows, 5)
+
+	assert.Equal(t, "controller.hosts[0]", valueRows[0].Key)
+	assert.Equal(t, "controller.hosts", valueRows[0].ParentKey)
+	assert.Equal(t, "", valueRows[0].ParentDescription)
+	assert.Equal(t, 2, valueRows[0].Depth)
+	assert.Equal(t, "controller.image.repository", valueRows[2].Key)
+	assert.Equal(t, "controller.image", valueRows[2].ParentKey)
+	assert.Equal(t, "The image", valueRows[2].ParentDescription)
+	assert.Equal(t, "replicas", valueRows[4].Key)
+	assert.Equal(t, 0, valueRows[4].Depth)
+
+	tree := getValuesTree(valueRows)
+	assert.Equal(t, []string{"controller", "replicas"}, getValueTreeNodeNames(tree.Children))
+
+	controller := tree.Children[0]
+	assert.Nil(t, controller.Row)
+	assert.Equal(t, []string{"hosts", "image"}, getValueTreeNodeNames(controller.Children))
+	assert.Equal(t, []string{"[0]"}, getValueTreeNodeNames(controller.Children[0].Children))
+
+	image := controller.Children[1]
+	assert.Equal(t, "controller.image", image.Key)
+	assert.Equal(t, "The image", image.Row.AutoDescription)
+	assert.Equal(t, []string{"repository", "tag"}, getValueTreeNodeNames(image.Leaves()))
+	assert.Equal(t, 2, image.Children[1].Depth)
+
+	assert.Equal(t, []string{"replicas"}, getValueTreeNodeNames(tree.Leaves()))
+	assert.Equal(t, []string{"controller"}, getValueTreeNodeNames(tree.Groups()))
+}
+
+func getValueTreeNodeNames(nodes []*valueTreeNode) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+
+	return names
+}
+
+func TestValuesTreeTemplates(t *testing.T) {
+	info := getTemplateTestChartInfo(t, `
+# -- Number of replicas
+replicas: 1
+
+controller:
+  # -- The image
+  image:
+    # -- The repository
+    repository: nginx
+    # -- The tag
+    tag: latest
+  hosts:
+    - example.com
+	`)
+
+	for format, expected := range map[string]string{
+		MarkdownOutputFormat: "## Values\n\n" +
+			"- **controller**\n" +
+			"  - **hosts**\n" +
+			"    - `[0]` (string) `\"example.com\"`\n" +
+			"  - **image** (object) - The image\n" +
+			"    - `repository` (string) `\"nginx\"` - The repository\n" +
+			"    - `tag` (string) `\"latest\"` - The tag\n" +
+			"- `replicas` (int) `1` - Number of replicas",
+		AsciiDocOutputFormat: "== Values\n\n" +
+			"* *controller*\n" +
+			"** *hosts*\n" +
+			"*** `+[0]+` (string) `+\"example.com\"+`\n" +
+			"** *image* (object) - The image\n" +
+			"*** `+repository+` (string) `+\"nginx\"+` - The repository\n" +
+			"*** `+tag+` (string) `+\"latest\"+` - The tag\n" +
+			"* `+replicas+` (int) `+1+` - Number of replicas",
+	} {
+		assert.Equal(t, expected, renderTestTemplate(t, info, `{{ template "chart.valuesSectionTree" . }}`, format), format)
+	}
+}
@@ -0,0 +1,125 @@
+package helm
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ArtifactHubChangesAnnotation      = "artifacthub.io/changes"
+	ArtifactHubImagesAnnotation       = "artifacthub.io/images"
+	ArtifactHubLinksAnnotation        = "artifacthub.io/links"
+	ArtifactHubCRDsExamplesAnnotation = "artifacthub.io/crdsExamples"
+)
+
+// ArtifactHubAnnotations are the annotations of a chart Artifact Hub shows on its page, parsed from the YAML documents
+// they contain.
+type ArtifactHubAnnotations struct {
+	Changes      []ArtifactHubChange
+	Images       []ArtifactHubImage
+	Links        []ArtifactHubLink
+	CRDsExamples []ArtifactHubCRDExample
+}
+
+type ArtifactHubLink struct {
+	Name string
+	URL  string `yaml:"url"`
+}
+
+// ArtifactHubChange is a change made in the current version of a chart. Its kind is one of added, changed, deprecated,
+// removed, fixed or security, and is empty for changes only given as a description.
+type ArtifactHubChange struct {
+	Kind        string
+	Description string
+	Links       []ArtifactHubLink
+}
+
+type ArtifactHubImage struct {
+	Name        string
+	Image       string
+	Whitelisted bool     `yaml:"whitelisted,omitempty"`
+	Platforms   []string `yaml:"platforms,omitempty"`
+}
+
+// ArtifactHubCRDExample is an example of a custom resource of a chart, along with the YAML document it is given as.
+type ArtifactHubCRDExample struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Yaml       string
+}
+
+func (change *ArtifactHubChange) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		change.Description = node.Value
+		return nil
+	}
+
+	type artifactHubChange ArtifactHubChange
+	return node.Decode((*artifactHubChange)(change))
+}
+
+func (example *ArtifactHubCRDExample) UnmarshalYAML(node *yaml.Node) error {
+	var object struct {
+		APIVersion string `yaml:"apiVersion"`
+		Kind       string
+		Metadata   struct {
+			Name string
+		}
+	}
+
+	if err := node.Decode(&object); err != nil {
+		return err
+	}
+
+	var document strings.Builder
+	encoder := yaml.NewEncoder(&document)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	example.APIVersion = object.APIVersion
+	example.Kind = object.Kind
+	example.Name = object.Metadata.Name
+	example.Yaml = document.String()
+
+	return nil
+}
+
+func parseArtifactHubAnnotation(chartDirectory string, annotations map[string]string, annotation string, out interface{}) {
+	payload, ok := annotations[annotation]
+	if !ok {
+		return
+	}
+
+	if err := yaml.Unmarshal([]byte(payload), out); err != nil {
+		log.Warnf("Failed to parse annotation %s of chart %s: %s", annotation, chartDirectory, err)
+	}
+}
+
+// parseArtifactHubAnnotations parses the Artifact Hub annotations of a chart, warning about those that aren't valid.
+func parseArtifactHubAnnotations(chartDirectory string, annotations map[string]string) ArtifactHubAnnotations {
+	var artifactHub ArtifactHubAnnotations
+
+	parseArtifactHubAnnotation(chartDirectory, annotations, ArtifactHubChangesAnnotation, &artifactHub.Changes)
+	parseArtifactHubAnnotation(chartDirectory, annotations, ArtifactHubImagesAnnotation, &artifactHub.Images)
+	parseArtifactHubAnnotation(chartDirectory, annotations, ArtifactHubLinksAnnotation, &artifactHub.Links)
+	parseArtifactHubAnnotation(chartDirectory, annotations, ArtifactHubCRDsExamplesAnnotation, &artifactHub.CRDsExamples)
+
+	for _, change := range artifactHub.Changes {
+		switch change.Kind {
+		case "", "added", "changed", "deprecated", "removed", "fixed", "security":
+		default:
+			log.Warnf("Change %q in annotation %s of chart %s has unknown kind %s", change.Description, ArtifactHubChangesAnnotation, chartDirectory, change.Kind)
+		}
+	}
+
+	return artifactHub
+}
@@ -0,0 +1,53 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestArtifactHubAnnotations() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	chartPath := filepath.Join("test-fixtures", "artifacthub-annotations")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+	artifactHub := info.ArtifactHub
+
+	suite.Equal([]helm.ArtifactHubChange{
+		{Kind: "fixed", Description: "Fix the service port", Links: []helm.ArtifactHubLink{{Name: "Issue", URL: "https://example.com/issues/1"}}},
+		{Description: "Bump the image"},
+		{Kind: "improved", Description: "Nothing"},
+	}, artifactHub.Changes)
+
+	suite.Equal([]helm.ArtifactHubImage{
+		{Name: "app", Image: "example.com/app:1.0.0", Whitelisted: true, Platforms: []string{"linux/amd64", "linux/arm64"}},
+	}, artifactHub.Images)
+
+	suite.Equal([]helm.ArtifactHubLink{{Name: "Support", URL: "https://example.com/support"}}, artifactHub.Links)
+
+	suite.Require().Len(artifactHub.CRDsExamples, 1)
+	suite.Equal("example.com/v1", artifactHub.CRDsExamples[0].APIVersion)
+	suite.Equal("Widget", artifactHub.CRDsExamples[0].Kind)
+	suite.Equal("my-widget", artifactHub.CRDsExamples[0].Name)
+	suite.Equal("apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: my-widget\nspec:\n  size: 3\n", artifactHub.CRDsExamples[0].Yaml)
+
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "has unknown kind improved")
+}
+
+func (suite *ChartParsingTestSuite) TestInvalidArtifactHubAnnotation() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	chartPath := filepath.Join("test-fixtures", "invalid-artifacthub-annotation")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Empty(info.ArtifactHub.Links)
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "Failed to parse annotation artifacthub.io/links")
+}
@@ -0,0 +1,298 @@
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/util"
+)
+
+// ValuesRevision is a snapshot of a chart's version and raw values file contents at some point in its history.
+type ValuesRevision struct {
+	ChartVersion string
+	Values       []byte
+
+	// Raw contents of the values documentation file, nil if the chart had none
+	ValuesDocs []byte
+}
+
+// BreakingValuesChange describes a value that was present in the base values file and was removed or changed type in
+// a way that consumers of the chart weren't warned about.
+type BreakingValuesChange struct {
+	Key     string
+	Problem string
+}
+
+func (c BreakingValuesChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Key, c.Problem)
+}
+
+type valueChange struct {
+	key         string
+	baseType    string
+	currentType string
+	deprecated  bool
+}
+
+func (c valueChange) removed() bool {
+	return c.currentType == ""
+}
+
+// FindBreakingValuesChangesSince compares the values file of the chart in chartDirectory against its contents at the
+// given git revision. Charts that did not exist at that revision have no breaking changes.
+func FindBreakingValuesChangesSince(revision string, chartDirectory string) ([]BreakingValuesChange, error) {
+	chartYamlPath := filepath.Join(chartDirectory, "Chart.yaml")
+	valuesPath := filepath.Join(chartDirectory, viper.GetString("values-file"))
+
+	if !util.GitFileExistsAtRevision(revision, chartYamlPath) || !util.GitFileExistsAtRevision(revision, valuesPath) {
+		log.Debugf("Chart %s has no values file at revision %s, skipping breaking change checks", chartDirectory, revision)
+		return nil, nil
+	}
+
+	base, err := readValuesRevisionFromGit(revision, chartYamlPath, valuesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	currentChartMeta, err := parseChartFile(chartDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	currentValues, err := getYamlFileContents(valuesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindBreakingValuesChanges(base, ValuesRevision{ChartVersion: currentChartMeta.Version, Values: currentValues})
+}
+
+// readValuesRevisionFromGit reads the version, values file and values documentation file of a chart at a git revision.
+func readValuesRevisionFromGit(revision string, chartYamlPath string, valuesPath string) (ValuesRevision, error) {
+	chartYamlContents, err := util.ReadGitFileAtRevision(revision, chartYamlPath)
+	if err != nil {
+		return ValuesRevision{}, err
+	}
+
+	var chartMeta ChartMeta
+	if err := yaml.Unmarshal(chartYamlContents, &chartMeta); err != nil {
+		return ValuesRevision{}, fmt.Errorf("failed to parse %s at revision %s: %w", chartYamlPath, revision, err)
+	}
+
+	valuesContents, err := util.ReadGitFileAtRevision(revision, valuesPath)
+	if err != nil {
+		return ValuesRevision{}, err
+	}
+
+	var valuesDocsContents []byte
+	if valuesDocsFile := viper.GetString("values-docs-file"); valuesDocsFile != "" {
+		valuesDocsPath := filepath.Join(filepath.Dir(chartYamlPath), valuesDocsFile)
+		if util.GitFileExistsAtRevision(revision, valuesDocsPath) {
+			valuesDocsContents, err = util.ReadGitFileAtRevision(revision, valuesDocsPath)
+			if err != nil {
+				return ValuesRevision{}, err
+			}
+		}
+	}
+
+	return ValuesRevision{
+		ChartVersion: chartMeta.Version,
+		Values:       bytes.ReplaceAll(valuesContents, []byte("\r\n"), []byte("\n")),
+		ValuesDocs:   valuesDocsContents,
+	}, nil
+}
+
+// FindBreakingValuesChanges reports every value of the base revision that is missing from, or has a different type in,
+// the current revision without having been marked with `@deprecated` in the base revision, either in a comment of the
+// values file or in the values documentation file. Removed values must also be accompanied by a major chart version bump.
+func FindBreakingValuesChanges(base ValuesRevision, current ValuesRevision) ([]BreakingValuesChange, error) {
+	var baseValues, currentValues yaml.Node
+
+	if err := yaml.Unmarshal(base.Values, &baseValues); err != nil {
+		return nil, fmt.Errorf("failed to parse base values: %w", err)
+	}
+
+	if err := yaml.Unmarshal(current.Values, &currentValues); err != nil {
+		return nil, fmt.Errorf("failed to parse current values: %w", err)
+	}
+
+	if len(baseValues.Content) == 0 {
+		return nil, nil
+	}
+
+	var currentRoot *yaml.Node
+	if len(currentValues.Content) > 0 {
+		currentRoot = currentValues.Content[0]
+	}
+
+	baseDescriptions := parseValuesComments(bytes.NewReader(base.Values))
+	if len(base.ValuesDocs) > 0 {
+		baseValuesDocs := make(map[string]ChartValueDescription)
+		if err := yaml.Unmarshal(base.ValuesDocs, &baseValuesDocs); err != nil {
+			return nil, fmt.Errorf("failed to parse base values documentation file: %w", err)
+		}
+
+		for key, description := range baseValuesDocs {
+			if description.Deprecated {
+				baseDescription := baseDescriptions[key]
+				baseDescription.Deprecated = true
+				baseDescriptions[key] = baseDescription
+			}
+		}
+	}
+
+	changes := collectValueChanges("", baseValues.Content[0], currentRoot, baseDescriptions, false)
+	majorVersionBumped := isMajorVersionBump(base.ChartVersion, current.ChartVersion)
+
+	breakingChanges := make([]BreakingValuesChange, 0)
+	for _, change := range changes {
+		if !change.deprecated {
+			if change.removed() {
+				breakingChanges = append(breakingChanges, BreakingValuesChange{
+					Key:     change.key,
+					Problem: "removed without first being marked @deprecated",
+				})
+			} else {
+				breakingChanges = append(breakingChanges, BreakingValuesChange{
+					Key:     change.key,
+					Problem: fmt.Sprintf("type changed from %s to %s without first being marked @deprecated", change.baseType, change.currentType),
+				})
+			}
+		}
+
+		if change.removed() && !majorVersionBumped {
+			breakingChanges = append(breakingChanges, BreakingValuesChange{
+				Key:     change.key,
+				Problem: fmt.Sprintf("removed without a major chart version bump (%s -> %s)", base.ChartVersion, current.ChartVersion),
+			})
+		}
+	}
+
+	return breakingChanges, nil
+}
+
+func collectValueChanges(prefix string, base *yaml.Node, current *yaml.Node, baseDescriptions map[string]ChartValueDescription, parentDeprecated bool) []valueChange {
+	base = resolveAlias(base)
+	current = resolveAlias(current)
+	changes := make([]valueChange, 0)
+
+	if base.Kind != yaml.MappingNode {
+		return changes
+	}
+
+	for i := 0; i < len(base.Content); i += 2 {
+		keyNode, baseValue := base.Content[i], resolveAlias(base.Content[i+1])
+		key := formatValuePath(prefix, keyNode.Value)
+		deprecated := parentDeprecated || baseDescriptions[key].Deprecated || hasDeprecatedComment(keyNode)
+
+		currentValue := findMappingValue(current, keyNode.Value)
+		if currentValue == nil {
+			changes = append(changes, valueChange{key: key, baseType: getNodeTypeName(baseValue), deprecated: deprecated})
+			continue
+		}
+
+		baseType, currentType := getNodeTypeName(baseValue), getNodeTypeName(currentValue)
+		if baseType != "" && currentType != "" && baseType != currentType {
+			changes = append(changes, valueChange{key: key, baseType: baseType, currentType: currentType, deprecated: deprecated})
+			continue
+		}
+
+		changes = append(changes, collectValueChanges(key, baseValue, currentValue, baseDescriptions, deprecated)...)
+	}
+
+	return changes
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	return node
+}
+
+func findMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return resolveAlias(mapping.Content[i+1])
+		}
+	}
+
+	return nil
+}
+
+func hasDeprecatedComment(keyNode *yaml.Node) bool {
+	for _, line := range strings.Split(keyNode.HeadComment, "\n") {
+		if deprecatedRegex.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getNodeTypeName returns the type of a values node using the same names as the values table, or "" for null values
+// which may legitimately be replaced by a value of any type.
+func getNodeTypeName(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.ScalarNode:
+		switch node.Tag {
+		case "!!null":
+			return ""
+		case "!!bool":
+			return "bool"
+		case "!!int":
+			return "int"
+		case "!!float":
+			return "float"
+		default:
+			return "string"
+		}
+	}
+
+	return ""
+}
+
+func isMajorVersionBump(baseVersion string, currentVersion string) bool {
+	base, err := semver.NewVersion(baseVersion)
+	if err != nil {
+		log.Warnf("Could not parse base chart version %q: %s", baseVersion, err)
+		return false
+	}
+
+	current, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		log.Warnf("Could not parse chart version %q: %s", currentVersion, err)
+		return false
+	}
+
+	return current.Major() > base.Major()
+}
+
+// formatValuePath builds value paths the same way they appear in the values table, quoting keys with dots or spaces.
+func formatValuePath(prefix string, key string) string {
+	if strings.Contains(key, ".") || strings.Contains(key, " ") {
+		key = fmt.Sprintf(`"%s"`, key)
+	}
+
+	if prefix == "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s.%s", prefix, key)
+}
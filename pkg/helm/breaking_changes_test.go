@@ -0,0 +1,122 @@
+package helm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func findBreakingChanges(t *testing.T, baseVersion string, baseValues string, currentVersion string, currentValues string) []string {
+	changes, err := helm.FindBreakingValuesChanges(
+		helm.ValuesRevision{ChartVersion: baseVersion, Values: []byte(baseValues)},
+		helm.ValuesRevision{ChartVersion: currentVersion, Values: []byte(currentValues)},
+	)
+	require.NoError(t, err)
+
+	result := make([]string, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, change.String())
+	}
+
+	return result
+}
+
+func TestNoBreakingChanges(t *testing.T) {
+	changes := findBreakingChanges(t, "1.0.0", `
+image:
+  repository: nginx
+  tag: ~
+`, "1.1.0", `
+image:
+  repository: nginx
+  tag: "1.25"
+  pullPolicy: IfNotPresent
+`)
+
+	assert.Empty(t, changes)
+}
+
+func TestRemovedValueWithoutDeprecation(t *testing.T) {
+	changes := findBreakingChanges(t, "1.0.0", `
+image:
+  repository: nginx
+  tag: latest
+`, "1.1.0", `
+image:
+  repository: nginx
+`)
+
+	assert.Equal(t, []string{
+		"image.tag: removed without first being marked @deprecated",
+		"image.tag: removed without a major chart version bump (1.0.0 -> 1.1.0)",
+	}, changes)
+}
+
+func TestRemovedDeprecatedValueWithMajorVersionBump(t *testing.T) {
+	changes := findBreakingChanges(t, "1.4.0", `
+# -- Legacy settings
+# @deprecated -- use image instead
+legacy:
+  tag: latest
+# old.setting -- An old-style comment
+# @deprecated
+old:
+  setting: true
+`, "2.0.0", `
+image: {}
+`)
+
+	assert.Empty(t, changes)
+}
+
+func TestRemovedDeprecatedValueWithoutMajorVersionBump(t *testing.T) {
+	changes := findBreakingChanges(t, "1.4.0", `
+# -- Legacy settings
+# @deprecated
+legacy: true
+`, "1.5.0", `
+image: {}
+`)
+
+	assert.Equal(t, []string{"legacy: removed without a major chart version bump (1.4.0 -> 1.5.0)"}, changes)
+}
+
+func TestChangedValueType(t *testing.T) {
+	changes := findBreakingChanges(t, "1.0.0", `
+annotations: []
+replicas: 1
+service:
+  # -- Port for the service
+  # @deprecated -- will become an object
+  port: 80
+`, "1.1.0", `
+annotations: {}
+replicas: "1"
+service:
+  port:
+    http: 80
+`)
+
+	assert.Equal(t, []string{
+		"annotations: type changed from list to object without first being marked @deprecated",
+		"replicas: type changed from int to string without first being marked @deprecated",
+	}, changes)
+}
+
+func TestRemovedValueDeprecatedInValuesDocsFile(t *testing.T) {
+	changes, err := helm.FindBreakingValuesChanges(
+		helm.ValuesRevision{
+			ChartVersion: "1.4.0",
+			Values:       []byte("legacy:\n  tag: latest\nold: true\n"),
+			ValuesDocs:   []byte("legacy:\n  description: Legacy settings\n  deprecated: true\n"),
+		},
+		helm.ValuesRevision{ChartVersion: "2.0.0", Values: []byte("image: {}\n")},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, "old: removed without first being marked @deprecated", changes[0].String())
+}
@@ -3,6 +3,7 @@ package helm
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -22,6 +23,11 @@ var defaultValueRegex = regexp.MustCompile("^\\s*# @default -- (.*)$")
 var valueTypeRegex = regexp.MustCompile("^\\((.*?)\\)\\s*(.*)$")
 var valueNotationTypeRegex = regexp.MustCompile("^\\s*#\\s+@notationType\\s+--\\s+(.*)$")
 var sectionRegex = regexp.MustCompile("^\\s*# @section -- (.*)$")
+var translatedDescriptionRegex = regexp.MustCompile("^\\s*#\\s*--\\[([A-Za-z]{2,3}(?:[-_][A-Za-z0-9]+)*)\\]\\s*(.*)$")
+var deprecatedRegex = regexp.MustCompile("^\\s*# @deprecated(?:\\s+--\\s*(.*))?$")
+var seeRegex = regexp.MustCompile("^\\s*# @see -- (.*)$")
+var exampleRegex = regexp.MustCompile("^\\s*#\\s+@example\\s*$")
+var imageRegex = regexp.MustCompile("^\\s*# @image(?:\\s+--\\s*(.*))?$")
 
 type ChartMetaMaintainer struct {
 	Email string
@@ -42,6 +48,13 @@ type ChartMeta struct {
 	Sources     []string
 	Engine      string
 	Maintainers []ChartMetaMaintainer
+	Icon        string
+	Keywords    []string
+	Annotations map[string]string
+
+	// Condition and tags of v1 charts, which are used by their parent charts to enable them
+	Condition string
+	Tags      string
 }
 
 type ChartRequirementsItem struct {
@@ -49,33 +62,103 @@ type ChartRequirementsItem struct {
 	Version    string
 	Repository string
 	Alias      string
+
+	// Comma separated paths of boolean values enabling the dependency, the first one set in the values is used
+	Condition string
+	Tags      []string
+
+	// Whether the dependency is enabled when neither its condition nor its tags are set in the values
+	Enabled *bool
+
+	// Values of the dependency imported into its parent chart, each either the name of a value the dependency
+	// exports, or a map with the child and parent keys
+	ImportValues []interface{} `yaml:"import-values"`
 }
 
 type ChartRequirements struct {
 	Dependencies []ChartRequirementsItem
+
+	// The dependencies in the order they're declared in, which the digest of the lock file depends on
+	declaredDependencies []ChartRequirementsItem
 }
 
 type ChartValueDescription struct {
-	Description  string
-	Default      string
-	Section      string
-	ValueType    string
-	NotationType string
+	Description        string `yaml:"description,omitempty"`
+	Default            string `yaml:"default,omitempty"`
+	Section            string `yaml:"section,omitempty"`
+	ValueType          string `yaml:"type,omitempty"`
+	NotationType       string `yaml:"notationType,omitempty"`
+	Deprecated         bool   `yaml:"deprecated,omitempty"`
+	DeprecationMessage string `yaml:"deprecationMessage,omitempty"`
+
+	// Keys of related values, from "# @see -- other.key" comments
+	See []string `yaml:"see,omitempty"`
+
+	// Example of the value as YAML, from the comment lines following "# @example"
+	Example string `yaml:"example,omitempty"`
+
+	// Translations of the description keyed by language, from "# --[de] Beschreibung" comments
+	Translations map[string]string `yaml:"-"`
+
+	// Whether the value references an image the chart runs, from "# @image" comments, which may name the image
+	Image     bool   `yaml:"image,omitempty"`
+	ImageName string `yaml:"imageName,omitempty"`
 }
 
 type ChartDocumentationInfo struct {
 	ChartMeta
 	ChartRequirements
 
+	// The lock file of the chart, nil if it has none
+	ChartLock *ChartLock
+
+	ArtifactHub ArtifactHubAnnotations
+
+	// The CRDs in the crds directory of the chart
+	CustomResourceDefinitions []CustomResourceDefinition
+
+	// The templates defined in the .tpl files of the templates directory of the chart
+	NamedTemplates []NamedTemplate
+
+	// The references to values in the templates of the chart
+	ValueUsages []ValueUsage
+
+	// The values the templates of the chart fail to render without
+	RequiredValues []RequiredValue
+
+	// The resources the chart creates with its default values, only rendered with --render-resources
+	RenderedResources []RenderedResource
+
+	// The roles and bindings among the rendered resources of the chart
+	RBAC ChartRBAC
+
+	// The API versions the chart creates resources of, from the literal apiVersion fields of its templates and from its
+	// rendered resources, sorted by API version and kind
+	KubernetesAPIs []KubernetesAPIUsage
+
+	// Whether the kubeVersion of the chart includes each of the Kubernetes versions known to helm-docs, nil if the chart
+	// has no kubeVersion
+	KubernetesCompatibility []KubernetesVersionCompatibility
+
 	ChartDirectory          string
 	ChartValues             *yaml.Node
 	ChartValuesDescriptions map[string]ChartValueDescription
+	ChartValuesTranslations map[string]map[string]string
 }
 
 type ChartValuesDocumentationParsingConfig struct {
 	StrictMode                 bool
 	AllowedMissingValuePaths   []string
 	AllowedMissingValueRegexps []*regexp.Regexp
+
+	// Whether to fail if templates use values missing from the values file, or values are used by no template
+	StrictTemplateValues bool
+
+	// Whether to fail if the roles the chart creates with its default values grant wildcard verbs or resources
+	StrictRBACWildcards bool
+
+	// Whether to fail if the lock file of the chart is out of sync with its dependencies or its vendored charts
+	StrictChartLock bool
 }
 
 func getYamlFileContents(filename string) ([]byte, error) {
@@ -147,6 +230,7 @@ func parseChartRequirementsFile(chartDirectory string, apiVersion string) (Chart
 		return chartRequirements, err
 	}
 
+	chartRequirements.declaredDependencies = append([]ChartRequirementsItem{}, chartRequirements.Dependencies...)
 	sort.Slice(chartRequirements.Dependencies[:], func(i, j int) bool {
 		return requirementKey(chartRequirements.Dependencies[i]) < requirementKey(chartRequirements.Dependencies[j])
 	})
@@ -183,6 +267,23 @@ func parseChartValuesFile(chartDirectory string) (yaml.Node, error) {
 	return values, err
 }
 
+// isIgnoredValuePath returns whether a value is allowed to be absent in strict mode, by its path or a regular expression.
+func isIgnoredValuePath(path string, config ChartValuesDocumentationParsingConfig) bool {
+	for _, ignorableValuePath := range config.AllowedMissingValuePaths {
+		if path == ignorableValuePath {
+			return true
+		}
+	}
+
+	for _, ignorableValueRegexp := range config.AllowedMissingValueRegexps {
+		if ignorableValueRegexp.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func checkDocumentation(rootNode *yaml.Node, comments map[string]ChartValueDescription, config ChartValuesDocumentationParsingConfig) error {
 	if len(rootNode.Content) == 0 {
 		return nil
@@ -190,14 +291,7 @@ func checkDocumentation(rootNode *yaml.Node, comments map[string]ChartValueDescr
 	valuesWithoutDocs := collectValuesWithoutDoc(rootNode.Content[0], comments, make([]string, 0))
 	valuesWithoutDocsAfterIgnore := make([]string, 0)
 	for _, valueWithoutDoc := range valuesWithoutDocs {
-		ignored := false
-		for _, ignorableValuePath := range config.AllowedMissingValuePaths {
-			ignored = ignored || valueWithoutDoc == ignorableValuePath
-		}
-		for _, ignorableValueRegexp := range config.AllowedMissingValueRegexps {
-			ignored = ignored || ignorableValueRegexp.MatchString(valueWithoutDoc)
-		}
-		if !ignored {
+		if !isIgnoredValuePath(valueWithoutDoc, config) {
 			valuesWithoutDocsAfterIgnore = append(valuesWithoutDocsAfterIgnore, valueWithoutDoc)
 		}
 	}
@@ -246,6 +340,24 @@ func parseChartValuesFileComments(chartDirectory string, values *yaml.Node, lint
 
 	defer valuesFile.Close()
 
+	keyToDescriptions := parseValuesComments(valuesFile)
+	err = mergeValuesDocsFile(chartDirectory, values, keyToDescriptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if lintingConfig.StrictMode {
+		err := checkDocumentation(values, keyToDescriptions, lintingConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyToDescriptions, nil
+}
+
+// parseValuesComments reads old-style value comments, those naming the full path of the value they document, from the
+// contents of a values file.
+func parseValuesComments(valuesFile io.Reader) map[string]ChartValueDescription {
 	keyToDescriptions := make(map[string]ChartValueDescription)
 	scanner := bufio.NewScanner(valuesFile)
 	foundValuesComment := false
@@ -293,13 +405,7 @@ func parseChartValuesFileComments(chartDirectory string, values *yaml.Node, lint
 		commentLines = make([]string, 0)
 		foundValuesComment = false
 	}
-	if lintingConfig.StrictMode {
-		err := checkDocumentation(values, keyToDescriptions, lintingConfig)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return keyToDescriptions, nil
+	return keyToDescriptions
 }
 
 func ParseChartInformation(chartDirectory string, documentationParsingConfig ChartValuesDocumentationParsingConfig) (ChartDocumentationInfo, error) {
@@ -312,21 +418,80 @@ func ParseChartInformation(chartDirectory string, documentationParsingConfig Cha
 		return chartDocInfo, err
 	}
 
+	chartDocInfo.ArtifactHub = parseArtifactHubAnnotations(chartDirectory, chartDocInfo.Annotations)
+	chartDocInfo.CustomResourceDefinitions = parseCustomResourceDefinitions(chartDirectory)
+	setCustomResourceDefinitionExamples(chartDocInfo.CustomResourceDefinitions, chartDocInfo.ArtifactHub.CRDsExamples)
+	chartDocInfo.NamedTemplates = parseNamedTemplates(chartDirectory)
+	templatesScan := scanChartTemplates(chartDirectory)
+	chartDocInfo.ValueUsages = templatesScan.usages
+	chartDocInfo.RequiredValues = templatesScan.requiredValues
+	apiUsages := templatesScan.apiUsages
+	if viper.GetBool("render-resources") || documentationParsingConfig.StrictRBACWildcards {
+		manifests, err := parseRenderedManifests(chartDirectory)
+		if err != nil {
+			// The wildcard check can't pass for roles it has no manifests of
+			if documentationParsingConfig.StrictRBACWildcards {
+				return chartDocInfo, err
+			}
+
+			log.Warnf("Chart %s has no rendered resources: %s", chartDirectory, err)
+		}
+
+		chartDocInfo.RenderedResources = getRenderedResources(manifests, chartDocInfo.CustomResourceDefinitions)
+		chartDocInfo.RBAC = getChartRBAC(manifests, templatesScan.rbacResources)
+		apiUsages = append(apiUsages, getRenderedAPIUsages(manifests)...)
+	}
+
+	chartDocInfo.KubernetesAPIs = getUniqueAPIUsages(apiUsages)
+	chartDocInfo.KubernetesCompatibility = getKubernetesCompatibility(chartDirectory, chartDocInfo.KubeVersion, chartDocInfo.KubernetesAPIs)
+
+	if documentationParsingConfig.StrictRBACWildcards {
+		if err := checkRBACWildcards(chartDocInfo.RBAC); err != nil {
+			return chartDocInfo, err
+		}
+	}
+
 	chartDocInfo.ChartRequirements, err = parseChartRequirementsFile(chartDirectory, chartDocInfo.ApiVersion)
 	if err != nil {
 		return chartDocInfo, err
 	}
 
+	chartDocInfo.ChartLock, err = parseChartLockFile(chartDirectory, chartDocInfo.ApiVersion)
+	if err != nil {
+		return chartDocInfo, err
+	}
+
+	if documentationParsingConfig.StrictChartLock {
+		err = checkChartLock(chartDirectory, chartDocInfo.ApiVersion, chartDocInfo.ChartRequirements, chartDocInfo.ChartLock)
+		if err != nil {
+			return chartDocInfo, err
+		}
+	}
+
 	chartValues, err := parseChartValuesFile(chartDirectory)
 	if err != nil {
 		return chartDocInfo, err
 	}
 
 	chartDocInfo.ChartValues = &chartValues
+	checkDependencyConditions(chartDirectory, chartDocInfo.ChartRequirements, &chartValues)
+
 	chartDocInfo.ChartValuesDescriptions, err = parseChartValuesFileComments(chartDirectory, &chartValues, documentationParsingConfig)
 	if err != nil {
 		return chartDocInfo, err
 	}
 
+	if documentationParsingConfig.StrictTemplateValues {
+		err = checkValueUsages(chartDirectory, chartDocInfo.ValueUsages, chartDocInfo.ChartRequirements, documentationParsingConfig)
+		if err != nil {
+			return chartDocInfo, err
+		}
+	}
+
+	chartDocInfo.ChartValuesTranslations, err = parseValuesDocsTranslations(chartDirectory)
+	if err != nil {
+		return chartDocInfo, err
+	}
+
 	return chartDocInfo, nil
 }
@@ -2,10 +2,14 @@ package helm_test
 
 import (
 	"github.com/norwoodj/helm-docs/pkg/helm"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/suite"
+	"io/fs"
+	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -15,6 +19,38 @@ type ChartParsingTestSuite struct {
 
 func (_ *ChartParsingTestSuite) SetupTest() {
 	viper.Set("values-file", "values.yaml")
+	viper.Set("values-docs-file", "values.docs.yaml")
+}
+
+func (_ *ChartParsingTestSuite) TearDownTest() {
+	viper.Reset()
+}
+
+// copyTestFixture copies a chart of the test-fixtures directory to a temporary directory, for tests modifying it.
+func (suite *ChartParsingTestSuite) copyTestFixture(name string) string {
+	source := filepath.Join("test-fixtures", name)
+	chartPath := filepath.Join(suite.T().TempDir(), name)
+
+	err := filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(chartPath, strings.TrimPrefix(path, source))
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(target, content, 0644)
+	})
+	suite.Require().NoError(err)
+
+	return chartPath
 }
 
 func TestChartParsingTestSuite(t *testing.T) {
@@ -94,3 +130,61 @@ func (suite *ChartParsingTestSuite) TestFullyDocumentedChartStrictModeOn() {
 	})
 	suite.NoError(err)
 }
+
+func (suite *ChartParsingTestSuite) TestValuesDocsFileIsMerged() {
+	chartPath := filepath.Join("test-fixtures", "values-docs-file")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{
+		StrictMode:               true,
+		AllowedMissingValuePaths: []string{"image.repository"},
+	})
+	suite.NoError(err)
+	suite.Equal(map[string]helm.ChartValueDescription{
+		"image":          {Description: "The image to deploy"},
+		"image.tag":      {Description: "The image tag", Section: "Image"},
+		"podAnnotations": {NotationType: "tpl"},
+		"serviceAccount": {Description: "Create a service account for the deployment", ValueType: "bool"},
+	}, info.ChartValuesDescriptions)
+}
+
+func (suite *ChartParsingTestSuite) TestValuesDocsFileConflictsAreReported() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	chartPath := filepath.Join("test-fixtures", "values-docs-file")
+	_, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.NoError(err)
+
+	warnings := make([]string, 0)
+	for _, entry := range hook.AllEntries() {
+		if strings.HasPrefix(entry.Message, "Conflicting") {
+			warnings = append(warnings, entry.Message)
+		}
+	}
+
+	suite.Equal([]string{
+		`Conflicting description for value serviceAccount in chart test-fixtures/values-docs-file: comment in values.yaml says "Whether to create a service account", values.docs.yaml says "Create a service account for the deployment". Using the latter`,
+	}, warnings)
+}
+
+func (suite *ChartParsingTestSuite) TestRenderValuesDocsFile() {
+	chartPath := filepath.Join("test-fixtures", "values-docs-file")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.NoError(err)
+
+	valuesDocs, err := helm.RenderValuesDocsFile(info)
+	suite.NoError(err)
+	suite.Equal(`image:
+  description: The image to deploy
+image.repository:
+  description: The image repository
+image.tag:
+  description: The image tag
+  section: Image
+podAnnotations:
+  description: Annotations of the pod
+  notationType: tpl
+serviceAccount:
+  description: Create a service account for the deployment
+  type: bool
+`, string(valuesDocs))
+}
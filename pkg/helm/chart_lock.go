@@ -0,0 +1,256 @@
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// ChartLock is the lock file of a chart, Chart.lock or requirements.lock for v1 charts, which pins the versions the
+// dependencies of the chart were resolved to.
+type ChartLock struct {
+	Generated    string
+	Digest       string
+	Dependencies []ChartLockItem
+}
+
+type ChartLockItem struct {
+	Name       string
+	Version    string
+	Repository string
+}
+
+// lockDigestDependency mirrors the JSON encoding of the dependencies Helm computes the digest of lock files from.
+type lockDigestDependency struct {
+	Name         string        `json:"name"`
+	Version      string        `json:"version,omitempty"`
+	Repository   string        `json:"repository"`
+	Condition    string        `json:"condition,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	Enabled      bool          `json:"enabled,omitempty"`
+	ImportValues []interface{} `json:"import-values,omitempty"`
+	Alias        string        `json:"alias,omitempty"`
+}
+
+func getChartLockFileName(apiVersion string) string {
+	if apiVersion == "v1" {
+		return "requirements.lock"
+	}
+
+	return "Chart.lock"
+}
+
+func parseChartLockFile(chartDirectory string, apiVersion string) (*ChartLock, error) {
+	lockPath := filepath.Join(chartDirectory, getChartLockFileName(apiVersion))
+	lockFileContents, err := getYamlFileContents(lockPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var chartLock ChartLock
+	if err := yaml.Unmarshal(lockFileContents, &chartLock); err != nil {
+		return nil, fmt.Errorf("failed to parse lock file %s: %w", lockPath, err)
+	}
+
+	return &chartLock, nil
+}
+
+// GetLockedVersion returns the version a dependency is locked to, or "" if it isn't locked.
+func (lock *ChartLock) GetLockedVersion(dependency ChartRequirementsItem) string {
+	if lock == nil {
+		return ""
+	}
+
+	for _, locked := range lock.Dependencies {
+		if locked.Name == dependency.Name && locked.Repository == dependency.Repository {
+			return locked.Version
+		}
+	}
+
+	return ""
+}
+
+// getChartLockDigest computes the digest of a lock file the way Helm does, from the dependencies in the order they're
+// declared in and the dependencies they were locked to.
+func getChartLockDigest(declared []ChartRequirementsItem, locked []ChartLockItem) (string, error) {
+	digestDependencies := [2][]lockDigestDependency{
+		make([]lockDigestDependency, 0, len(declared)),
+		make([]lockDigestDependency, 0, len(locked)),
+	}
+
+	for _, dependency := range declared {
+		digestDependencies[0] = append(digestDependencies[0], lockDigestDependency{
+			Name:         dependency.Name,
+			Version:      dependency.Version,
+			Repository:   dependency.Repository,
+			Condition:    dependency.Condition,
+			Tags:         dependency.Tags,
+			Enabled:      dependency.Enabled != nil && *dependency.Enabled,
+			ImportValues: dependency.ImportValues,
+			Alias:        dependency.Alias,
+		})
+	}
+
+	for _, dependency := range locked {
+		digestDependencies[1] = append(digestDependencies[1], lockDigestDependency{
+			Name:       dependency.Name,
+			Version:    dependency.Version,
+			Repository: dependency.Repository,
+		})
+	}
+
+	data, err := json.Marshal(digestDependencies)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(digest[:]), nil
+}
+
+// readPackagedChartMeta reads the Chart.yaml file of a packaged chart.
+func readPackagedChartMeta(packagePath string) (ChartMeta, error) {
+	var chartMeta ChartMeta
+
+	packageFile, err := os.Open(packagePath)
+	if err != nil {
+		return chartMeta, err
+	}
+	defer packageFile.Close()
+
+	gzipReader, err := gzip.NewReader(packageFile)
+	if err != nil {
+		return chartMeta, err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return chartMeta, fmt.Errorf("no Chart.yaml found in %s", packagePath)
+		}
+
+		if err != nil {
+			return chartMeta, err
+		}
+
+		// Packaged charts contain a single directory named after the chart
+		if strings.Count(strings.Trim(header.Name, "/"), "/") != 1 || path.Base(header.Name) != "Chart.yaml" {
+			continue
+		}
+
+		var chartYaml bytes.Buffer
+		if _, err := io.Copy(&chartYaml, tarReader); err != nil {
+			return chartMeta, err
+		}
+
+		err = yaml.Unmarshal(chartYaml.Bytes(), &chartMeta)
+		return chartMeta, err
+	}
+}
+
+// checkChartLock returns an error if the lock file of a chart is out of sync with the dependencies declared by the chart,
+// or if charts vendored in the charts directory don't match the versions they're locked to.
+func checkChartLock(chartDirectory string, apiVersion string, requirements ChartRequirements, lock *ChartLock) error {
+	if lock == nil {
+		return nil
+	}
+
+	lockFileName := getChartLockFileName(apiVersion)
+	problems := make([]string, 0)
+	inSync := true
+
+	for _, dependency := range requirements.Dependencies {
+		lockedVersion := lock.GetLockedVersion(dependency)
+		if lockedVersion == "" {
+			problems = append(problems, fmt.Sprintf("dependency %s is missing from %s", dependency.GetName(), lockFileName))
+			inSync = false
+			continue
+		}
+
+		constraint, err := semver.NewConstraint(dependency.Version)
+		if err != nil {
+			continue
+		}
+
+		version, err := semver.NewVersion(lockedVersion)
+		if err == nil && !constraint.Check(version) {
+			problems = append(problems, fmt.Sprintf("dependency %s is locked to version %s in %s, which doesn't satisfy %s", dependency.GetName(), lockedVersion, lockFileName, dependency.Version))
+			inSync = false
+		}
+	}
+
+	for _, locked := range lock.Dependencies {
+		declared := false
+		for _, dependency := range requirements.Dependencies {
+			declared = declared || (dependency.Name == locked.Name && dependency.Repository == locked.Repository)
+		}
+
+		if !declared {
+			problems = append(problems, fmt.Sprintf("dependency %s locked in %s is no longer declared", locked.Name, lockFileName))
+			inSync = false
+		}
+	}
+
+	// Helm resolves repositories given by the name of a configured repository to its URL before computing the digest,
+	// so it can only be checked without them
+	checkDigest := inSync
+	for _, dependency := range requirements.declaredDependencies {
+		if strings.HasPrefix(dependency.Repository, "@") || strings.HasPrefix(dependency.Repository, "alias:") {
+			checkDigest = false
+		}
+	}
+
+	if checkDigest {
+		digest, err := getChartLockDigest(requirements.declaredDependencies, lock.Dependencies)
+		if err == nil && digest != lock.Digest {
+			problems = append(problems, fmt.Sprintf("the digest of %s doesn't match the declared dependencies", lockFileName))
+		}
+	}
+
+	packagePaths, _ := filepath.Glob(filepath.Join(chartDirectory, "charts", "*.tgz"))
+	for _, packagePath := range packagePaths {
+		packagedChart, err := readPackagedChartMeta(packagePath)
+		if err != nil {
+			log.Warnf("Failed to read vendored chart %s: %s", packagePath, err)
+			continue
+		}
+
+		lockedVersion := ""
+		for _, locked := range lock.Dependencies {
+			if locked.Name == packagedChart.Name {
+				lockedVersion = locked.Version
+				break
+			}
+		}
+
+		if lockedVersion == "" {
+			problems = append(problems, fmt.Sprintf("vendored chart %s is not locked in %s", filepath.Base(packagePath), lockFileName))
+		} else if lockedVersion != packagedChart.Version {
+			problems = append(problems, fmt.Sprintf("vendored chart %s has version %s, but %s locks version %s", filepath.Base(packagePath), packagedChart.Version, lockFileName, lockedVersion))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s is out of sync with the dependencies of the chart, run helm dependency update: \n%s", lockFileName, strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestChartLockInSync() {
+	chartPath := filepath.Join("test-fixtures", "chart-lock")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{StrictChartLock: true})
+	suite.Require().NoError(err)
+
+	suite.Require().NotNil(info.ChartLock)
+	suite.Equal("17.0.4", info.ChartLock.GetLockedVersion(info.Dependencies[1]))
+	suite.Equal("2.1.0", info.ChartLock.GetLockedVersion(info.Dependencies[0]))
+}
+
+func (suite *ChartParsingTestSuite) TestChartLockDigestOutOfSync() {
+	chartPath := filepath.Join("test-fixtures", "chart-lock-digest-out-of-sync")
+	_, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{StrictChartLock: true})
+
+	suite.EqualError(err, "Chart.lock is out of sync with the dependencies of the chart, run helm dependency update: \nthe digest of Chart.lock doesn't match the declared dependencies")
+}
+
+func (suite *ChartParsingTestSuite) TestChartLockOutOfSync() {
+	chartPath := filepath.Join("test-fixtures", "chart-lock-out-of-sync")
+	_, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{StrictChartLock: true})
+
+	suite.EqualError(err, `Chart.lock is out of sync with the dependencies of the chart, run helm dependency update: 
+dependency common is missing from Chart.lock
+dependency redis is locked to version 16.13.2 in Chart.lock, which doesn't satisfy ~17.0.0
+dependency postgresql locked in Chart.lock is no longer declared
+vendored chart redis-17.0.4.tgz has version 17.0.4, but Chart.lock locks version 16.13.2`)
+
+	// The lock file is only checked in strict mode
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+	suite.Equal("16.13.2", info.ChartLock.GetLockedVersion(info.Dependencies[1]))
+}
+
+func (suite *ChartParsingTestSuite) TestChartWithoutLock() {
+	chartPath := filepath.Join("test-fixtures", "chart-without-lock")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{StrictChartLock: true})
+	suite.Require().NoError(err)
+
+	suite.Nil(info.ChartLock)
+	suite.Empty(info.ChartLock.GetLockedVersion(info.Dependencies[0]))
+}
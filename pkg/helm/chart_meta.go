@@ -0,0 +1,53 @@
+package helm
+
+import (
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+// HelmMetadata returns the metadata of the chart, including its dependencies, as Helm models it, for templates relying
+// on the field names Helm uses.
+func (info ChartDocumentationInfo) HelmMetadata() *chart.Metadata {
+	metadata := &chart.Metadata{
+		Name:         info.Name,
+		Home:         info.Home,
+		Sources:      info.Sources,
+		Version:      info.Version,
+		Description:  info.Description,
+		Keywords:     info.Keywords,
+		Maintainers:  make([]*chart.Maintainer, 0, len(info.Maintainers)),
+		Icon:         info.Icon,
+		APIVersion:   info.ApiVersion,
+		Condition:    info.Condition,
+		Tags:         info.Tags,
+		AppVersion:   info.AppVersion,
+		Deprecated:   info.Deprecated,
+		Annotations:  info.Annotations,
+		KubeVersion:  info.KubeVersion,
+		Dependencies: make([]*chart.Dependency, 0, len(info.Dependencies)),
+		Type:         info.Type,
+	}
+
+	for _, maintainer := range info.Maintainers {
+		metadata.Maintainers = append(metadata.Maintainers, &chart.Maintainer{Name: maintainer.Name, Email: maintainer.Email, URL: maintainer.Url})
+	}
+
+	dependencies := info.declaredDependencies
+	if dependencies == nil {
+		dependencies = info.Dependencies
+	}
+
+	for _, dependency := range dependencies {
+		metadata.Dependencies = append(metadata.Dependencies, &chart.Dependency{
+			Name:         dependency.Name,
+			Version:      dependency.Version,
+			Repository:   dependency.Repository,
+			Condition:    dependency.Condition,
+			Tags:         dependency.Tags,
+			Enabled:      dependency.IsEnabled(info.ChartValues),
+			ImportValues: dependency.ImportValues,
+			Alias:        dependency.Alias,
+		})
+	}
+
+	return metadata
+}
@@ -0,0 +1,67 @@
+package helm_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+const metadataTestChartYaml = `
+apiVersion: v2
+name: app
+version: 1.0.0
+appVersion: 2.3.4
+icon: https://example.com/icon.png
+keywords:
+  - web
+  - proxy
+annotations:
+  category: Networking
+  artifacthub.io/license: Apache-2.0
+maintainers:
+  - name: John Doe
+    url: https://example.com/john
+dependencies:
+  - name: redis
+    version: ~17.0.0
+    repository: https://charts.example.com
+    condition: redis.enabled
+    tags:
+      - cache
+`
+
+func TestChartMetadata(t *testing.T) {
+	viper.Set("values-file", "values.yaml")
+	defer viper.Reset()
+
+	chartDirectory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "Chart.yaml"), []byte(metadataTestChartYaml), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "values.yaml"), []byte("redis:\n  enabled: false\n"), 0644))
+
+	info, err := helm.ParseChartInformation(chartDirectory, helm.ChartValuesDocumentationParsingConfig{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.3.4", info.AppVersion)
+	assert.Equal(t, "https://example.com/icon.png", info.Icon)
+	assert.Equal(t, []string{"web", "proxy"}, info.Keywords)
+	assert.Equal(t, map[string]string{"category": "Networking", "artifacthub.io/license": "Apache-2.0"}, info.Annotations)
+
+	metadata := info.HelmMetadata()
+	assert.Equal(t, "v2", metadata.APIVersion)
+	assert.Equal(t, "2.3.4", metadata.AppVersion)
+	assert.Equal(t, info.Annotations, metadata.Annotations)
+	require.Len(t, metadata.Maintainers, 1)
+	assert.Equal(t, "https://example.com/john", metadata.Maintainers[0].URL)
+
+	require.Len(t, metadata.Dependencies, 1)
+	assert.Equal(t, "redis.enabled", metadata.Dependencies[0].Condition)
+	assert.Equal(t, []string{"cache"}, metadata.Dependencies[0].Tags)
+	assert.False(t, metadata.Dependencies[0].Enabled)
+	require.NoError(t, metadata.Validate())
+}
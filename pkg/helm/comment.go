@@ -17,7 +17,7 @@ func ParseComment(commentLines []string) (string, ChartValueDescription) {
 	// the last "group" of comment lines starting with '# --'.
 	lastIndex := 0
 	for i, v := range commentLines {
-		if strings.HasPrefix(v, PrefixComment) {
+		if strings.HasPrefix(v, PrefixComment) && !translatedDescriptionRegex.MatchString(v) {
 			lastIndex = i
 		}
 	}
@@ -28,7 +28,7 @@ func ParseComment(commentLines []string) (string, ChartValueDescription) {
 
 	for i := range commentLines {
 		match := valuesDescriptionRegex.FindStringSubmatch(commentLines[i])
-		if len(match) < 3 {
+		if len(match) < 3 || translatedDescriptionRegex.MatchString(commentLines[i]) {
 			continue
 		}
 
@@ -45,18 +45,46 @@ func ParseComment(commentLines []string) (string, ChartValueDescription) {
 	}
 
 	var isRaw = false
+	var isExample = false
+	var translationLanguage string
 
 	for _, line := range commentLines[docStartIdx+1:] {
 		rawFlagMatch := rawDescriptionRegex.FindStringSubmatch(line)
 		defaultCommentMatch := defaultValueRegex.FindStringSubmatch(line)
 		notationTypeCommentMatch := valueNotationTypeRegex.FindStringSubmatch(line)
 		sectionCommentMatch := sectionRegex.FindStringSubmatch(line)
+		deprecatedCommentMatch := deprecatedRegex.FindStringSubmatch(line)
+		translatedDescriptionMatch := translatedDescriptionRegex.FindStringSubmatch(line)
+		seeCommentMatch := seeRegex.FindStringSubmatch(line)
+		imageCommentMatch := imageRegex.FindStringSubmatch(line)
 
 		if !isRaw && len(rawFlagMatch) == 1 {
 			isRaw = true
 			continue
 		}
 
+		if exampleRegex.MatchString(line) {
+			isExample = true
+			translationLanguage = ""
+			continue
+		}
+
+		if len(translatedDescriptionMatch) > 2 {
+			isExample = false
+			translationLanguage = translatedDescriptionMatch[1]
+			if c.Translations == nil {
+				c.Translations = make(map[string]string)
+			}
+			c.Translations[translationLanguage] = translatedDescriptionMatch[2]
+			continue
+		}
+
+		// Any annotation ends the description in the language of a preceding translation
+		if len(defaultCommentMatch) > 1 || len(notationTypeCommentMatch) > 1 || len(sectionCommentMatch) > 1 || len(deprecatedCommentMatch) > 1 || len(seeCommentMatch) > 1 || len(imageCommentMatch) > 1 {
+			translationLanguage = ""
+			isExample = false
+		}
+
 		if len(defaultCommentMatch) > 1 {
 			c.Default = defaultCommentMatch[1]
 			continue
@@ -72,8 +100,46 @@ func ParseComment(commentLines []string) (string, ChartValueDescription) {
 			continue
 		}
 
+		if len(deprecatedCommentMatch) > 1 {
+			c.Deprecated = true
+			c.DeprecationMessage = deprecatedCommentMatch[1]
+			continue
+		}
+
+		if len(seeCommentMatch) > 1 {
+			c.See = append(c.See, strings.TrimSpace(seeCommentMatch[1]))
+			continue
+		}
+
+		if len(imageCommentMatch) > 1 {
+			c.Image = true
+			c.ImageName = strings.TrimSpace(imageCommentMatch[1])
+			continue
+		}
+
 		commentContinuationMatch := commentContinuationRegex.FindStringSubmatch(line)
 
+		if isExample {
+			if len(commentContinuationMatch) > 1 {
+				if c.Example != "" {
+					c.Example += "\n"
+				}
+				c.Example += commentContinuationMatch[2]
+			}
+			continue
+		}
+
+		if translationLanguage != "" {
+			if len(commentContinuationMatch) > 1 {
+				separator := " "
+				if isRaw {
+					separator = "\n"
+				}
+				c.Translations[translationLanguage] += separator + commentContinuationMatch[2]
+			}
+			continue
+		}
+
 		if isRaw {
 
 			if len(commentContinuationMatch) > 1 {
@@ -0,0 +1,288 @@
+package helm
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// CustomResourceDefinition is a CRD shipped in the crds directory of a chart.
+type CustomResourceDefinition struct {
+	// Path of the file defining the CRD, relative to the chart directory
+	File string
+
+	Name     string
+	Group    string
+	Kind     string
+	Plural   string
+	Scope    string
+	Versions []CustomResourceDefinitionVersion
+
+	// Examples of the custom resource from the artifacthub.io/crdsExamples annotation of the chart
+	Examples []ArtifactHubCRDExample
+}
+
+type CustomResourceDefinitionVersion struct {
+	Name               string
+	Served             bool
+	Storage            bool
+	Deprecated         bool
+	DeprecationWarning string
+
+	// Fields of the custom resource, other than its apiVersion, kind and metadata, flattened in the order of their paths
+	Properties []CustomResourceProperty
+}
+
+// CustomResourceProperty is a field of a custom resource, with the path of fields in lists suffixed with [] and of
+// fields in maps with .*, e.g. spec.containers[].name or spec.labels.*.
+type CustomResourceProperty struct {
+	Path        string
+	Type        string
+	Description string
+	Required    bool
+}
+
+type crdSchema struct {
+	Type                 string
+	Format               string
+	Description          string
+	Properties           map[string]crdSchema
+	Items                *crdSchema
+	AdditionalProperties *crdSchemaOrBool `yaml:"additionalProperties"`
+	Required             []string
+	IntOrString          bool `yaml:"x-kubernetes-int-or-string"`
+	PreserveUnknown      bool `yaml:"x-kubernetes-preserve-unknown-fields"`
+}
+
+// crdSchemaOrBool is the schema of the additional properties of an object, which may also just be a boolean.
+type crdSchemaOrBool struct {
+	Schema *crdSchema
+}
+
+func (s *crdSchemaOrBool) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	s.Schema = &crdSchema{}
+	return node.Decode(s.Schema)
+}
+
+type crdValidation struct {
+	OpenAPIV3Schema *crdSchema `yaml:"openAPIV3Schema"`
+}
+
+type crdManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string
+	Metadata   struct {
+		Name string
+	}
+	Spec struct {
+		Group string
+		Scope string
+		Names struct {
+			Kind   string
+			Plural string
+		}
+
+		// The version and schema of v1beta1 CRDs, which may also be given for all versions
+		Version    string
+		Validation *crdValidation
+
+		Versions []struct {
+			Name               string
+			Served             bool
+			Storage            bool
+			Deprecated         bool
+			DeprecationWarning string `yaml:"deprecationWarning"`
+			Schema             *crdValidation
+		}
+	}
+}
+
+func getCRDSchemaType(schema crdSchema) string {
+	switch {
+	case schema.IntOrString:
+		return "int-or-string"
+	case schema.Type == "array" && schema.Items != nil:
+		return "[]" + getCRDSchemaType(*schema.Items)
+	case schema.Type == "object" && len(schema.Properties) == 0 && schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+		return "map[string]" + getCRDSchemaType(*schema.AdditionalProperties.Schema)
+	case schema.Format != "":
+		return schema.Type + " (" + schema.Format + ")"
+	case schema.Type == "" && schema.PreserveUnknown:
+		return "any"
+	}
+
+	return schema.Type
+}
+
+// collectCRDProperties flattens the properties of a schema, sorted by name like kubectl explain does.
+func collectCRDProperties(prefix string, schema crdSchema, properties *[]CustomResourceProperty) {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if prefix == "" && (name == "apiVersion" || name == "kind" || name == "metadata") {
+			continue
+		}
+
+		property := schema.Properties[name]
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		*properties = append(*properties, CustomResourceProperty{
+			Path:        path,
+			Type:        getCRDSchemaType(property),
+			Description: strings.Join(strings.Fields(property.Description), " "),
+			Required:    required[name],
+		})
+
+		collectNestedCRDProperties(path, property, properties)
+	}
+}
+
+func collectNestedCRDProperties(path string, schema crdSchema, properties *[]CustomResourceProperty) {
+	switch {
+	case len(schema.Properties) > 0:
+		collectCRDProperties(path, schema, properties)
+	case schema.Items != nil:
+		collectNestedCRDProperties(path+"[]", *schema.Items, properties)
+	case schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+		collectNestedCRDProperties(path+".*", *schema.AdditionalProperties.Schema, properties)
+	}
+}
+
+func getCustomResourceDefinition(manifest crdManifest) CustomResourceDefinition {
+	crd := CustomResourceDefinition{
+		Name:   manifest.Metadata.Name,
+		Group:  manifest.Spec.Group,
+		Kind:   manifest.Spec.Names.Kind,
+		Plural: manifest.Spec.Names.Plural,
+		Scope:  manifest.Spec.Scope,
+	}
+
+	if len(manifest.Spec.Versions) == 0 && manifest.Spec.Version != "" {
+		crdVersion := CustomResourceDefinitionVersion{Name: manifest.Spec.Version, Served: true, Storage: true}
+		if manifest.Spec.Validation != nil && manifest.Spec.Validation.OpenAPIV3Schema != nil {
+			collectCRDProperties("", *manifest.Spec.Validation.OpenAPIV3Schema, &crdVersion.Properties)
+		}
+
+		crd.Versions = append(crd.Versions, crdVersion)
+	}
+
+	for _, version := range manifest.Spec.Versions {
+		crdVersion := CustomResourceDefinitionVersion{
+			Name:               version.Name,
+			Served:             version.Served,
+			Storage:            version.Storage,
+			Deprecated:         version.Deprecated,
+			DeprecationWarning: version.DeprecationWarning,
+		}
+
+		schema := manifest.Spec.Validation
+		if version.Schema != nil {
+			schema = version.Schema
+		}
+
+		if schema != nil && schema.OpenAPIV3Schema != nil {
+			collectCRDProperties("", *schema.OpenAPIV3Schema, &crdVersion.Properties)
+		}
+
+		crd.Versions = append(crd.Versions, crdVersion)
+	}
+
+	return crd
+}
+
+// parseCustomResourceDefinitions parses the CRDs in the crds directory of a chart, sorted by name. Files which can't be
+// parsed are reported as warnings and left out.
+func parseCustomResourceDefinitions(chartDirectory string) []CustomResourceDefinition {
+	crds := make([]CustomResourceDefinition, 0)
+	crdsDirectory := filepath.Join(chartDirectory, "crds")
+
+	err := filepath.Walk(crdsDirectory, func(path string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+
+			return err
+		}
+
+		extension := filepath.Ext(path)
+		if fileInfo.IsDir() || (extension != ".yaml" && extension != ".yml" && extension != ".json") {
+			return nil
+		}
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			log.Warnf("Failed to read CRD file %s: %s", path, err)
+			return nil
+		}
+
+		relativePath, _ := filepath.Rel(chartDirectory, path)
+		decoder := yaml.NewDecoder(bytes.NewReader(contents))
+		for {
+			var manifest crdManifest
+			err := decoder.Decode(&manifest)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if err != nil {
+				log.Warnf("Failed to parse CRD file %s: %s", path, err)
+				break
+			}
+
+			if manifest.Kind != "CustomResourceDefinition" {
+				continue
+			}
+
+			crd := getCustomResourceDefinition(manifest)
+			crd.File = filepath.ToSlash(relativePath)
+			crds = append(crds, crd)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Warnf("Failed to read the CRDs of chart %s: %s", chartDirectory, err)
+	}
+
+	sort.SliceStable(crds, func(i, j int) bool {
+		return crds[i].Name < crds[j].Name
+	})
+
+	return crds
+}
+
+// setCustomResourceDefinitionExamples sets the examples of each CRD from the artifacthub.io/crdsExamples annotation.
+func setCustomResourceDefinitionExamples(crds []CustomResourceDefinition, examples []ArtifactHubCRDExample) {
+	for i := range crds {
+		for _, example := range examples {
+			if example.Kind == crds[i].Kind && strings.HasPrefix(example.APIVersion, crds[i].Group+"/") {
+				crds[i].Examples = append(crds[i].Examples, example)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestParseCustomResourceDefinitions() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "crds"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+	suite.Require().Len(info.CustomResourceDefinitions, 2)
+
+	gadgets := info.CustomResourceDefinitions[0]
+	suite.Equal("gadgets.example.com", gadgets.Name)
+	suite.Equal("crds/gadgets.yml", gadgets.File)
+	suite.Equal("Cluster", gadgets.Scope)
+	suite.Equal([]helm.CustomResourceDefinitionVersion{{
+		Name:    "v1alpha1",
+		Served:  true,
+		Storage: true,
+		Properties: []helm.CustomResourceProperty{
+			{Path: "spec"},
+			{Path: "spec.enabled", Type: "boolean"},
+		},
+	}}, gadgets.Versions)
+	suite.Empty(gadgets.Examples)
+
+	widgets := info.CustomResourceDefinitions[1]
+	suite.Equal("widgets.example.com", widgets.Name)
+	suite.Equal("example.com", widgets.Group)
+	suite.Equal("Widget", widgets.Kind)
+	suite.Equal("widgets", widgets.Plural)
+	suite.Require().Len(widgets.Versions, 2)
+
+	suite.Equal([]helm.CustomResourceProperty{
+		{Path: "spec", Type: "object", Description: "Desired state of the widget."},
+		{Path: "spec.labels", Type: "map[string]string"},
+		{Path: "spec.parts", Type: "[]object"},
+		{Path: "spec.parts[].name", Type: "string", Required: true},
+		{Path: "spec.parts[].port", Type: "int-or-string"},
+		{Path: "spec.size", Type: "integer (int32)", Required: true},
+	}, widgets.Versions[0].Properties)
+
+	suite.Equal(helm.CustomResourceDefinitionVersion{Name: "v1beta1", Deprecated: true, DeprecationWarning: "use v1"}, widgets.Versions[1])
+
+	suite.Require().Len(widgets.Examples, 1)
+	suite.Equal("my-widget", widgets.Examples[0].Name)
+}
+
+func (suite *ChartParsingTestSuite) TestInvalidCustomResourceDefinition() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "invalid-crd"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Empty(info.CustomResourceDefinitions)
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "Failed to parse CRD file")
+}
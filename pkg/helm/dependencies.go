@@ -0,0 +1,168 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// ChartImportValue imports the values of a dependency at the Child key into its parent chart at the Parent key. An
+// empty Parent key imports the values into the root of the parent's values.
+type ChartImportValue struct {
+	Child  string
+	Parent string
+}
+
+// GetImportValues returns the values imported from a dependency into its parent chart. Values exported by the
+// dependency are imported from its exports key into the root of the parent's values, the way Helm imports them.
+func (item ChartRequirementsItem) GetImportValues() []ChartImportValue {
+	var importValues []ChartImportValue
+
+	for _, importValue := range item.ImportValues {
+		switch v := importValue.(type) {
+		case string:
+			importValues = append(importValues, ChartImportValue{Child: "exports." + v})
+		case map[string]interface{}:
+			child, _ := v["child"].(string)
+			parent, _ := v["parent"].(string)
+			if child == "" {
+				log.Warnf("Import value of dependency %s has no child key, ignoring it", item.Name)
+				continue
+			}
+
+			importValues = append(importValues, ChartImportValue{Child: child, Parent: strings.Trim(parent, ".")})
+		default:
+			log.Warnf("Import value of dependency %s has an unsupported format, ignoring it", item.Name)
+		}
+	}
+
+	return importValues
+}
+
+// GetName returns the name the values of a dependency are nested under in the values of its parent chart.
+func (item ChartRequirementsItem) GetName() string {
+	if item.Alias != "" {
+		return item.Alias
+	}
+
+	return item.Name
+}
+
+// GetConditions returns the paths of the values given in the condition of a dependency.
+func (item ChartRequirementsItem) GetConditions() []string {
+	conditions := make([]string, 0)
+	for _, condition := range strings.Split(item.Condition, ",") {
+		if condition = strings.TrimSpace(condition); condition != "" {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	return conditions
+}
+
+// IsEnabled returns whether a dependency is enabled with the given values, the way Helm evaluates it: the first of its
+// conditions set to a boolean decides, otherwise it's enabled if any of its tags is true and disabled if they're all
+// false. Dependencies whose condition and tags aren't set are enabled unless their enabled field says otherwise.
+func (item ChartRequirementsItem) IsEnabled(values *yaml.Node) bool {
+	for _, condition := range item.GetConditions() {
+		if enabled, ok := getBoolValue(values, condition); ok {
+			return enabled
+		}
+	}
+
+	hasTrueTag, hasFalseTag := false, false
+	for _, tag := range item.Tags {
+		if enabled, ok := getBoolValue(values, "tags."+tag); ok {
+			hasTrueTag = hasTrueTag || enabled
+			hasFalseTag = hasFalseTag || !enabled
+		}
+	}
+
+	if hasTrueTag || hasFalseTag {
+		return hasTrueTag
+	}
+
+	return item.Enabled == nil || *item.Enabled
+}
+
+// lookupValuePath returns the node of the value at the given dot separated path of a values file, or nil if it isn't
+// set.
+func lookupValuePath(values *yaml.Node, path string) *yaml.Node {
+	node := resolveAlias(values)
+	if node != nil && node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = resolveAlias(node.Content[0])
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		if node = findMappingValue(node, key); node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+func getBoolValue(values *yaml.Node, path string) (bool, bool) {
+	node := lookupValuePath(values, path)
+	if node == nil || node.Kind != yaml.ScalarNode || node.Tag != "!!bool" {
+		return false, false
+	}
+
+	var value bool
+	if err := node.Decode(&value); err != nil {
+		return false, false
+	}
+
+	return value, true
+}
+
+// getVendoredDependencyValues returns the values file of a dependency vendored into the charts directory of its parent
+// chart, or nil if it isn't vendored or can't be parsed.
+func getVendoredDependencyValues(chartDirectory string, dependency ChartRequirementsItem) *yaml.Node {
+	valuesPath := filepath.Join(chartDirectory, "charts", dependency.Name, "values.yaml")
+	contents, err := os.ReadFile(valuesPath)
+	if err != nil {
+		return nil
+	}
+
+	var values yaml.Node
+	if err := yaml.Unmarshal(contents, &values); err != nil {
+		log.Debugf("Failed to parse the values file %s of dependency %s: %s", valuesPath, dependency.Name, err)
+		return nil
+	}
+
+	return &values
+}
+
+// checkDependencyConditions warns about conditions of dependencies which refer to values that aren't set in the values
+// file, nor in the values file of the dependency itself when it's vendored, since Helm silently ignores them and the
+// dependency is then enabled regardless.
+func checkDependencyConditions(chartDirectory string, requirements ChartRequirements, values *yaml.Node) {
+	for _, dependency := range requirements.Dependencies {
+		var dependencyValues *yaml.Node
+		dependencyValuesRead := false
+
+		for _, condition := range dependency.GetConditions() {
+			if lookupValuePath(values, condition) != nil {
+				continue
+			}
+
+			// The values of the dependency are nested under its name in the values of its parent chart
+			if dependencyPath := strings.TrimPrefix(condition, dependency.GetName()+"."); dependencyPath != condition {
+				if !dependencyValuesRead {
+					dependencyValues = getVendoredDependencyValues(chartDirectory, dependency)
+					dependencyValuesRead = true
+				}
+
+				if dependencyValues != nil && lookupValuePath(dependencyValues, dependencyPath) != nil {
+					continue
+				}
+			}
+
+			log.Warnf("Condition %s of dependency %s of chart %s is not set in the values file", condition, dependency.GetName(), chartDirectory)
+		}
+	}
+}
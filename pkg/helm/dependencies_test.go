@@ -0,0 +1,147 @@
+package helm_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func parseDependency(t *testing.T, dependency string) helm.ChartRequirementsItem {
+	var item helm.ChartRequirementsItem
+	require.NoError(t, yaml.Unmarshal([]byte(dependency), &item))
+	return item
+}
+
+func parseValues(t *testing.T, values string) *yaml.Node {
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(values), &node))
+	return &node
+}
+
+func TestGetImportValues(t *testing.T) {
+	dependency := parseDependency(t, `
+name: redis
+import-values:
+  - connection
+  - child: exports.metrics
+    parent: .
+  - child: auth
+    parent: redis.auth
+`)
+
+	assert.Equal(t, []helm.ChartImportValue{
+		{Child: "exports.connection"},
+		{Child: "exports.metrics"},
+		{Child: "auth", Parent: "redis.auth"},
+	}, dependency.GetImportValues())
+}
+
+func TestDependencyEnabledByCondition(t *testing.T) {
+	dependency := parseDependency(t, `
+name: postgresql
+condition: postgresql.enabled, database.enabled
+tags:
+  - database
+`)
+
+	assert.Equal(t, []string{"postgresql.enabled", "database.enabled"}, dependency.GetConditions())
+	assert.False(t, dependency.IsEnabled(parseValues(t, `
+database:
+  enabled: false
+tags:
+  database: true
+`)))
+	assert.True(t, dependency.IsEnabled(parseValues(t, `
+postgresql:
+  enabled: true
+database:
+  enabled: false
+`)))
+}
+
+func TestDependencyEnabledByTags(t *testing.T) {
+	dependency := parseDependency(t, `
+name: prometheus
+tags:
+  - monitoring
+  - metrics
+`)
+
+	assert.True(t, dependency.IsEnabled(parseValues(t, "tags: {monitoring: false, metrics: true}")))
+	assert.False(t, dependency.IsEnabled(parseValues(t, "tags: {monitoring: false}")))
+	assert.True(t, dependency.IsEnabled(parseValues(t, "replicas: 1")))
+	assert.False(t, parseDependency(t, "{name: prometheus, enabled: false}").IsEnabled(parseValues(t, "replicas: 1")))
+}
+
+func TestMissingDependencyConditionIsReported(t *testing.T) {
+	viper.Set("values-file", "values.yaml")
+	defer viper.Reset()
+
+	chartDirectory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "Chart.yaml"), []byte(`
+apiVersion: v2
+name: app
+version: 1.0.0
+dependencies:
+  - name: redis
+    version: 17.0.0
+    condition: redis.enabled
+  - name: postgresql
+    version: 12.0.0
+    condition: db.enabled
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "values.yaml"), []byte("redis:\n  enabled: true\n"), 0644))
+
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	_, err := helm.ParseChartInformation(chartDirectory, helm.ChartValuesDocumentationParsingConfig{})
+	require.NoError(t, err)
+
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, "Condition db.enabled of dependency postgresql of chart "+chartDirectory+" is not set in the values file", hook.LastEntry().Message)
+}
+
+func TestDependencyConditionSetInVendoredDependency(t *testing.T) {
+	viper.Set("values-file", "values.yaml")
+	defer viper.Reset()
+
+	chartDirectory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "Chart.yaml"), []byte(`
+apiVersion: v2
+name: app
+version: 1.0.0
+dependencies:
+  - name: postgresql
+    version: 12.0.0
+    condition: postgresql.enabled
+  - name: redis
+    alias: cache
+    version: 17.0.0
+    condition: cache.enabled
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "values.yaml"), []byte("replicas: 1\n"), 0644))
+
+	for _, dependency := range []string{"postgresql", "redis"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(chartDirectory, "charts", dependency), 0755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "charts", "postgresql", "values.yaml"), []byte("enabled: false\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(chartDirectory, "charts", "redis", "values.yaml"), []byte("replicas: 1\n"), 0644))
+
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	_, err := helm.ParseChartInformation(chartDirectory, helm.ChartValuesDocumentationParsingConfig{})
+	require.NoError(t, err)
+
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, "Condition cache.enabled of dependency cache of chart "+chartDirectory+" is not set in the values file", hook.LastEntry().Message)
+}
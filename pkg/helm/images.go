@@ -0,0 +1,401 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// ValueImage is an image a chart runs, referenced by one of its values.
+type ValueImage struct {
+	// Key of the value referencing the image
+	Key   string
+	Name  string
+	Image string
+}
+
+// isImageKey returns whether a value is conventionally named for an image, e.g. image or sidecarImage.
+func isImageKey(key string) bool {
+	return strings.HasSuffix(strings.ToLower(key), "image")
+}
+
+func getScalarValue(mapping *yaml.Node, key string) string {
+	node := findMappingValue(mapping, key)
+	if node == nil || node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+		return ""
+	}
+
+	return strings.TrimSpace(node.Value)
+}
+
+// resolveImageTag resolves the tag of an image, which defaults to the appVersion of the chart when it's empty or a
+// template rendering it.
+func resolveImageTag(tag string, appVersion string) (string, bool) {
+	if tag == "" {
+		return appVersion, true
+	}
+
+	if strings.Contains(tag, "{{") {
+		return appVersion, strings.Contains(tag, ".Chart.AppVersion")
+	}
+
+	return tag, true
+}
+
+// getImageReference returns the image a value references, either as a string or as an object with a repository and
+// optionally a registry, tag and digest.
+func getImageReference(node *yaml.Node, appVersion string) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		image := strings.TrimSpace(node.Value)
+		if image == "" || node.Tag != "!!str" || strings.ContainsAny(image, " {}") {
+			return "", fmt.Errorf("%q is not an image", node.Value)
+		}
+
+		if strings.Contains(image, "@") || strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") || appVersion == "" {
+			return image, nil
+		}
+
+		return image + ":" + appVersion, nil
+
+	case yaml.MappingNode:
+		image := getScalarValue(node, "repository")
+		if image == "" {
+			return "", fmt.Errorf("no repository is set")
+		}
+
+		if registry := getScalarValue(node, "registry"); registry != "" {
+			image = registry + "/" + image
+		}
+
+		tag, ok := resolveImageTag(getScalarValue(node, "tag"), appVersion)
+		if !ok {
+			return "", fmt.Errorf("tag %s can't be resolved", getScalarValue(node, "tag"))
+		}
+
+		if tag != "" {
+			image += ":" + tag
+		}
+
+		if digest := getScalarValue(node, "digest"); digest != "" {
+			image += "@" + digest
+		}
+
+		return image, nil
+	}
+
+	return "", fmt.Errorf("images must be strings or objects")
+}
+
+// isConventionalImageValue returns whether a value has the shape of an image reference, a string named like an image,
+// or an object with a repository along with a tag or digest, or named like an image.
+func isConventionalImageValue(key string, node *yaml.Node) bool {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return isImageKey(key) && node.Tag == "!!str" && node.Value != "" && !strings.ContainsAny(node.Value, " {}")
+	case yaml.MappingNode:
+		if getScalarValue(node, "repository") == "" {
+			return false
+		}
+
+		return isImageKey(key) || findMappingValue(node, "tag") != nil || findMappingValue(node, "digest") != nil
+	}
+
+	return false
+}
+
+// getValueImageName names an image after the key of the value referencing it, without the image part of the key, or
+// after the chart if the value is just named image.
+func getValueImageName(chartName string, key string) string {
+	name := key
+	if i := strings.LastIndex(key, "."); i >= 0 && isImageKey(key[i+1:]) {
+		name = key[:i] + "." + key[i+1:len(key)-len("image")]
+	} else if isImageKey(key) {
+		name = key[:len(key)-len("image")]
+	}
+
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return chartName
+	}
+
+	return name
+}
+
+// FindValueImages returns the images referenced by the values of a chart, found by the conventional shapes of image
+// values or by "# @image" comments, with tags defaulting to the appVersion of the chart.
+func FindValueImages(info ChartDocumentationInfo) []ValueImage {
+	images := make([]ValueImage, 0)
+	if info.ChartValues == nil || info.ChartValues.Kind != yaml.DocumentNode || len(info.ChartValues.Content) == 0 {
+		return images
+	}
+
+	descriptions := GetInlineValueDescriptions(info.ChartValues, info.ChartValuesDescriptions)
+	seen := make(map[string]bool)
+	var collect func(prefix string, key string, node *yaml.Node)
+	collect = func(prefix string, key string, node *yaml.Node) {
+		node = resolveAlias(node)
+		description := descriptions[prefix]
+
+		if description.Image || isConventionalImageValue(key, node) {
+			image, err := getImageReference(node, info.AppVersion)
+			if err != nil {
+				log.Warnf("Failed to resolve the image referenced by value %s of chart %s: %s", prefix, info.ChartDirectory, err)
+				return
+			}
+
+			name := description.ImageName
+			if name == "" {
+				name = getValueImageName(info.Name, prefix)
+			}
+
+			if !seen[image] {
+				seen[image] = true
+				images = append(images, ValueImage{Key: prefix, Name: name, Image: image})
+			}
+
+			return
+		}
+
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i < len(node.Content); i += 2 {
+				collect(formatValuePath(prefix, node.Content[i].Value), node.Content[i].Value, node.Content[i+1])
+			}
+		case yaml.SequenceNode:
+			for i, item := range node.Content {
+				collect(fmt.Sprintf("%s[%d]", prefix, i), "", item)
+			}
+		}
+	}
+
+	collect("", "", info.ChartValues.Content[0])
+	return images
+}
+
+// GetArtifactHubImages returns the images the artifacthub.io/images annotation of a chart should list, those referenced
+// by its values, keeping the platforms and whitelisting of images the annotation already lists under the same name.
+// Images the annotation lists which aren't referenced by any value, e.g. those only used by hooks, are kept after them.
+func GetArtifactHubImages(info ChartDocumentationInfo) []ArtifactHubImage {
+	existingByName := make(map[string]ArtifactHubImage, len(info.ArtifactHub.Images))
+	for _, image := range info.ArtifactHub.Images {
+		existingByName[image.Name] = image
+	}
+
+	valueImages := FindValueImages(info)
+	images := make([]ArtifactHubImage, 0, len(valueImages))
+	for _, valueImage := range valueImages {
+		image := ArtifactHubImage{Name: valueImage.Name, Image: valueImage.Image}
+		if existing, ok := existingByName[valueImage.Name]; ok {
+			image.Whitelisted = existing.Whitelisted
+			image.Platforms = existing.Platforms
+		}
+
+		images = append(images, image)
+		delete(existingByName, valueImage.Name)
+	}
+
+	for _, image := range info.ArtifactHub.Images {
+		if _, ok := existingByName[image.Name]; ok {
+			images = append(images, image)
+		}
+	}
+
+	return images
+}
+
+// FindStaleArtifactHubImages returns how the artifacthub.io/images annotation of a chart differs from the images
+// referenced by its values. Images which aren't referenced by any value aren't reported, since they're kept.
+func FindStaleArtifactHubImages(info ChartDocumentationInfo) []string {
+	problems := make([]string, 0)
+	existingByName := make(map[string]ArtifactHubImage, len(info.ArtifactHub.Images))
+	for _, image := range info.ArtifactHub.Images {
+		existingByName[image.Name] = image
+	}
+
+	for _, image := range GetArtifactHubImages(info) {
+		existing, ok := existingByName[image.Name]
+		delete(existingByName, image.Name)
+
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("image %s (%s) is missing", image.Name, image.Image))
+		case existing.Image != image.Image:
+			problems = append(problems, fmt.Sprintf("image %s is %s, but the values reference %s", image.Name, existing.Image, image.Image))
+		}
+	}
+
+	return problems
+}
+
+// UpdateArtifactHubImages sets the artifacthub.io/images annotation of a chart to the images referenced by its values,
+// leaving the rest of its Chart.yaml file as it is. It returns whether the annotation was out of date.
+func UpdateArtifactHubImages(info ChartDocumentationInfo, dryRun bool) (bool, error) {
+	if len(FindStaleArtifactHubImages(info)) == 0 {
+		return false, nil
+	}
+
+	chartYamlPath := filepath.Join(info.ChartDirectory, "Chart.yaml")
+	chartYaml, err := os.ReadFile(chartYamlPath)
+	if err != nil {
+		return true, err
+	}
+
+	var images strings.Builder
+	if artifactHubImages := GetArtifactHubImages(info); len(artifactHubImages) > 0 {
+		encoder := yaml.NewEncoder(&images)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(artifactHubImages); err != nil {
+			return true, err
+		}
+
+		if err := encoder.Close(); err != nil {
+			return true, err
+		}
+	}
+
+	updatedChartYaml, err := setChartAnnotation(chartYaml, ArtifactHubImagesAnnotation, images.String())
+	if err != nil {
+		return true, fmt.Errorf("failed to update %s: %w", chartYamlPath, err)
+	}
+
+	if dryRun {
+		fmt.Printf("# %s\n%s", chartYamlPath, updatedChartYaml)
+		return true, nil
+	}
+
+	return true, os.WriteFile(chartYamlPath, updatedChartYaml, 0o644)
+}
+
+// setChartAnnotation sets an annotation of a Chart.yaml file to the given value as a literal block, or removes it if the
+// value is empty. Only the lines of the annotation are changed, so that the formatting and comments of the rest of the
+// file are kept.
+func setChartAnnotation(chartYaml []byte, annotation string, value string) ([]byte, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(chartYaml, &document); err != nil {
+		return nil, err
+	}
+
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("chart metadata must be a map")
+	}
+
+	newline := "\n"
+	if strings.Contains(string(chartYaml), "\r\n") {
+		newline = "\r\n"
+	}
+
+	lines := strings.SplitAfter(string(chartYaml), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		lines[len(lines)-1] += newline
+	}
+
+	renderEntry := func(indent string) []string {
+		if value == "" {
+			return nil
+		}
+
+		entry := []string{indent + annotation + ": |" + newline}
+		for _, line := range strings.Split(strings.TrimSuffix(value, "\n"), "\n") {
+			entry = append(entry, indent+"  "+line+newline)
+		}
+
+		return entry
+	}
+
+	// replaceLines replaces the lines from start up to end, both 1-based
+	replaceLines := func(start int, end int, replacement []string) []byte {
+		updated := append([]string{}, lines[:start-1]...)
+		updated = append(updated, replacement...)
+		updated = append(updated, lines[end-1:]...)
+		return []byte(strings.Join(updated, ""))
+	}
+
+	// entryEnd returns the line an entry ends before, leaving out the blank lines and comments which precede the next
+	// entry
+	entryEnd := func(start int, next int, indent int) int {
+		end := next
+		for end-1 > start {
+			line := strings.TrimRight(lines[end-2], "\r\n")
+			trimmed := strings.TrimLeft(line, " ")
+			if trimmed != "" && (!strings.HasPrefix(trimmed, "#") || len(line)-len(trimmed) > indent) {
+				break
+			}
+
+			end--
+		}
+
+		return end
+	}
+
+	chart := document.Content[0]
+	annotationsIndex := -1
+	for i := 0; i < len(chart.Content); i += 2 {
+		if chart.Content[i].Value == "annotations" {
+			annotationsIndex = i
+		}
+	}
+
+	if annotationsIndex < 0 {
+		if value == "" {
+			return chartYaml, nil
+		}
+
+		entry := append([]string{"annotations:" + newline}, renderEntry("  ")...)
+		return replaceLines(len(lines)+1, len(lines)+1, entry), nil
+	}
+
+	nextTopLevelLine := len(lines) + 1
+	if annotationsIndex+2 < len(chart.Content) {
+		nextTopLevelLine = chart.Content[annotationsIndex+2].Line
+	}
+
+	annotationsKey, annotations := chart.Content[annotationsIndex], resolveAlias(chart.Content[annotationsIndex+1])
+	isEmpty := annotations.Kind == yaml.ScalarNode && annotations.Tag == "!!null" ||
+		annotations.Kind == yaml.MappingNode && len(annotations.Content) == 0
+
+	if isEmpty {
+		if value == "" {
+			return chartYaml, nil
+		}
+
+		indent := strings.Repeat(" ", annotationsKey.Column-1)
+		entry := append([]string{indent + "annotations:" + newline}, renderEntry(indent+"  ")...)
+		return replaceLines(annotationsKey.Line, annotationsKey.Line+1, entry), nil
+	}
+
+	if annotations.Kind != yaml.MappingNode || annotations.Style&yaml.FlowStyle != 0 {
+		return nil, fmt.Errorf("annotations must be a block map to be updated")
+	}
+
+	indent := annotations.Content[0].Column - 1
+	for i := 0; i < len(annotations.Content); i += 2 {
+		if annotations.Content[i].Value != annotation {
+			continue
+		}
+
+		next := nextTopLevelLine
+		if i+2 < len(annotations.Content) {
+			next = annotations.Content[i+2].Line
+		}
+
+		start := annotations.Content[i].Line
+		return replaceLines(start, entryEnd(start, next, indent), renderEntry(strings.Repeat(" ", indent))), nil
+	}
+
+	if value == "" {
+		return chartYaml, nil
+	}
+
+	lastEntryLine := annotations.Content[len(annotations.Content)-2].Line
+	end := entryEnd(lastEntryLine, nextTopLevelLine, indent)
+	return replaceLines(end, end, renderEntry(strings.Repeat(" ", indent))), nil
+}
@@ -0,0 +1,124 @@
+package helm_test
+
+import (
+	"os"
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestFindValueImages() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "images"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.ValueImage{
+		{Key: "image", Name: "app", Image: "example.com/app:1.2.3"},
+		{Key: "metrics.exporter", Name: "metrics.exporter", Image: "quay.io/example/exporter:0.10@sha256:abc"},
+		{Key: "sidecars[0].image", Name: "sidecars[0]", Image: "envoyproxy/envoy:1.2.3"},
+		{Key: "initImage", Name: "init", Image: "busybox:1.36"},
+		{Key: "migrations.container", Name: "migrations", Image: "example.com/migrations:2.0.0"},
+	}, helm.FindValueImages(info))
+
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "Failed to resolve the image referenced by value worker.image")
+}
+
+func (suite *ChartParsingTestSuite) TestFindStaleArtifactHubImages() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "stale-artifacthub-images"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]string{
+		"image app is example.com/app:1.2.2, but the values reference example.com/app:1.2.3",
+		"image metrics.exporter (quay.io/example/exporter:0.10@sha256:abc) is missing",
+		"image sidecars[0] (envoyproxy/envoy:1.2.3) is missing",
+		"image migrations (example.com/migrations:2.0.0) is missing",
+	}, helm.FindStaleArtifactHubImages(info))
+}
+
+func (suite *ChartParsingTestSuite) TestUpdateArtifactHubImages() {
+	chartPath := suite.copyTestFixture("artifacthub-images-update")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	updated, err := helm.UpdateArtifactHubImages(info, false)
+	suite.Require().NoError(err)
+	suite.True(updated)
+
+	chartYaml, err := os.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+	suite.Require().NoError(err)
+
+	suite.Equal(`# The app chart
+apiVersion: v2
+name: app
+version: 1.0.0
+appVersion: 1.2.3
+
+annotations:
+  # Shown on Artifact Hub
+  category: Networking
+  artifacthub.io/images: |
+    - name: app
+      image: example.com/app:1.2.3
+      platforms:
+        - linux/amd64
+    - name: metrics.exporter
+      image: quay.io/example/exporter:0.10@sha256:abc
+    - name: sidecars[0]
+      image: envoyproxy/envoy:1.2.3
+    - name: init
+      image: busybox:1.36
+    - name: migrations
+      image: example.com/migrations:2.0.0
+    - name: hook
+      image: example.com/hook:1.0.0
+  artifacthub.io/license: Apache-2.0
+
+# Maintained by the platform team
+maintainers:
+  - name: John Doe
+`, string(chartYaml))
+
+	// The updated chart is up to date
+	info, err = helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	updated, err = helm.UpdateArtifactHubImages(info, false)
+	suite.Require().NoError(err)
+	suite.False(updated)
+}
+
+func (suite *ChartParsingTestSuite) TestUpdateArtifactHubImagesWithoutAnnotations() {
+	chartPath := suite.copyTestFixture("artifacthub-images-without-annotations")
+	info, err := helm.ParseChartInformation(chartPath, helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	_, err = helm.UpdateArtifactHubImages(info, false)
+	suite.Require().NoError(err)
+
+	chartYaml, err := os.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+	suite.Require().NoError(err)
+
+	suite.Equal(`apiVersion: v2
+name: app
+version: 1.0.0
+appVersion: 1.2.3
+annotations:
+  artifacthub.io/images: |
+    - name: app
+      image: example.com/app:1.2.3
+    - name: metrics.exporter
+      image: quay.io/example/exporter:0.10@sha256:abc
+    - name: sidecars[0]
+      image: envoyproxy/envoy:1.2.3
+    - name: init
+      image: busybox:1.36
+    - name: migrations
+      image: example.com/migrations:2.0.0
+type: application
+`, string(chartYaml))
+}
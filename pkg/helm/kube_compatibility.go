@@ -0,0 +1,232 @@
+package helm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+)
+
+// Matches the versions with a patch number a kubeVersion constraint is written with, e.g. 1.25.3 of !=1.25.3
+var constraintBoundRegex = regexp.MustCompile(`v?(\d+)\.(\d+)\.(\d+)`)
+
+// The Kubernetes minor versions charts are checked against, i.e. the minor versions of Kubernetes 1
+var kubernetesMinorVersions = []int{16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}
+
+// kubernetesAPIDeprecation is a deprecated API version of a kind, with the minor versions of Kubernetes 1 deprecating
+// and removing it and the API version replacing it, if any.
+type kubernetesAPIDeprecation struct {
+	apiVersion   string
+	kind         string
+	deprecatedIn int
+	removedIn    int
+	replacement  string
+}
+
+// The API versions removed from Kubernetes since 1.16, following https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var kubernetesAPIDeprecations = []kubernetesAPIDeprecation{
+	{"apps/v1beta1", "Deployment", 9, 16, "apps/v1"},
+	{"apps/v1beta1", "StatefulSet", 9, 16, "apps/v1"},
+	{"apps/v1beta2", "DaemonSet", 9, 16, "apps/v1"},
+	{"apps/v1beta2", "Deployment", 9, 16, "apps/v1"},
+	{"apps/v1beta2", "ReplicaSet", 9, 16, "apps/v1"},
+	{"apps/v1beta2", "StatefulSet", 9, 16, "apps/v1"},
+	{"extensions/v1beta1", "DaemonSet", 9, 16, "apps/v1"},
+	{"extensions/v1beta1", "Deployment", 9, 16, "apps/v1"},
+	{"extensions/v1beta1", "ReplicaSet", 9, 16, "apps/v1"},
+	{"extensions/v1beta1", "NetworkPolicy", 9, 16, "networking.k8s.io/v1"},
+	{"extensions/v1beta1", "PodSecurityPolicy", 11, 16, "policy/v1beta1"},
+	{"admissionregistration.k8s.io/v1beta1", "MutatingWebhookConfiguration", 16, 22, "admissionregistration.k8s.io/v1"},
+	{"admissionregistration.k8s.io/v1beta1", "ValidatingWebhookConfiguration", 16, 22, "admissionregistration.k8s.io/v1"},
+	{"apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", 16, 22, "apiextensions.k8s.io/v1"},
+	{"apiregistration.k8s.io/v1beta1", "APIService", 19, 22, "apiregistration.k8s.io/v1"},
+	{"certificates.k8s.io/v1beta1", "CertificateSigningRequest", 19, 22, "certificates.k8s.io/v1"},
+	{"coordination.k8s.io/v1beta1", "Lease", 19, 22, "coordination.k8s.io/v1"},
+	{"extensions/v1beta1", "Ingress", 14, 22, "networking.k8s.io/v1"},
+	{"networking.k8s.io/v1beta1", "Ingress", 19, 22, "networking.k8s.io/v1"},
+	{"networking.k8s.io/v1beta1", "IngressClass", 19, 22, "networking.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRole", 17, 22, "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRoleBinding", 17, 22, "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "Role", 17, 22, "rbac.authorization.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", "RoleBinding", 17, 22, "rbac.authorization.k8s.io/v1"},
+	{"scheduling.k8s.io/v1beta1", "PriorityClass", 14, 22, "scheduling.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "CSIDriver", 19, 22, "storage.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "CSINode", 17, 22, "storage.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "StorageClass", 19, 22, "storage.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "VolumeAttachment", 19, 22, "storage.k8s.io/v1"},
+	{"autoscaling/v2beta1", "HorizontalPodAutoscaler", 22, 25, "autoscaling/v2"},
+	{"batch/v1beta1", "CronJob", 21, 25, "batch/v1"},
+	{"discovery.k8s.io/v1beta1", "EndpointSlice", 21, 25, "discovery.k8s.io/v1"},
+	{"events.k8s.io/v1beta1", "Event", 19, 25, "events.k8s.io/v1"},
+	{"node.k8s.io/v1beta1", "RuntimeClass", 20, 25, "node.k8s.io/v1"},
+	{"policy/v1beta1", "PodDisruptionBudget", 21, 25, "policy/v1"},
+	{"policy/v1beta1", "PodSecurityPolicy", 21, 25, ""},
+	{"autoscaling/v2beta2", "HorizontalPodAutoscaler", 23, 26, "autoscaling/v2"},
+	{"flowcontrol.apiserver.k8s.io/v1beta1", "FlowSchema", 23, 26, "flowcontrol.apiserver.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta1", "PriorityLevelConfiguration", 23, 26, "flowcontrol.apiserver.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", "CSIStorageCapacity", 24, 27, "storage.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta2", "FlowSchema", 26, 29, "flowcontrol.apiserver.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta2", "PriorityLevelConfiguration", 26, 29, "flowcontrol.apiserver.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta3", "FlowSchema", 29, 32, "flowcontrol.apiserver.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta3", "PriorityLevelConfiguration", 29, 32, "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// KubernetesAPIChange is an API version used by a chart which a Kubernetes version deprecates or no longer serves.
+type KubernetesAPIChange struct {
+	KubernetesAPIUsage
+
+	// API version to migrate to, empty if the API was removed without a replacement
+	Replacement string
+}
+
+// KubernetesVersionCompatibility is whether a chart supports a minor version of Kubernetes according to its kubeVersion
+// constraint, and the API versions used by the chart which the Kubernetes version deprecates or no longer serves.
+type KubernetesVersionCompatibility struct {
+	// The minor version, e.g. 1.29
+	Version string
+
+	// Whether the kubeVersion constraint includes the version, and whether it only includes some of its patch releases
+	Supported bool
+	Partial   bool
+
+	RemovedAPIs    []KubernetesAPIChange
+	DeprecatedAPIs []KubernetesAPIChange
+}
+
+// getUniqueAPIUsages returns the first usage of each API version and kind, sorted by API version and kind.
+func getUniqueAPIUsages(usages []KubernetesAPIUsage) []KubernetesAPIUsage {
+	uniqueUsages := make([]KubernetesAPIUsage, 0, len(usages))
+	seen := make(map[string]bool)
+	for _, usage := range usages {
+		key := usage.APIVersion + " " + usage.Kind
+		if !seen[key] {
+			seen[key] = true
+			uniqueUsages = append(uniqueUsages, usage)
+		}
+	}
+
+	sort.SliceStable(uniqueUsages, func(i, j int) bool {
+		if uniqueUsages[i].APIVersion != uniqueUsages[j].APIVersion {
+			return uniqueUsages[i].APIVersion < uniqueUsages[j].APIVersion
+		}
+
+		return uniqueUsages[i].Kind < uniqueUsages[j].Kind
+	})
+
+	return uniqueUsages
+}
+
+func getRenderedAPIUsages(manifests []renderedManifest) []KubernetesAPIUsage {
+	usages := make([]KubernetesAPIUsage, 0, len(manifests))
+	for _, manifest := range manifests {
+		usages = append(usages, KubernetesAPIUsage{APIVersion: manifest.APIVersion, Kind: manifest.Kind, File: manifest.file})
+	}
+
+	return usages
+}
+
+func findAPIDeprecation(usage KubernetesAPIUsage) (kubernetesAPIDeprecation, bool) {
+	for _, deprecation := range kubernetesAPIDeprecations {
+		if deprecation.apiVersion == usage.APIVersion && deprecation.kind == usage.Kind {
+			return deprecation, true
+		}
+	}
+
+	return kubernetesAPIDeprecation{}, false
+}
+
+func formatAPIUsagePosition(usage KubernetesAPIUsage) string {
+	if usage.Line == 0 {
+		return usage.File
+	}
+
+	return fmt.Sprintf("%s:%d", usage.File, usage.Line)
+}
+
+// checkMinorVersion returns whether a kubeVersion constraint includes any patch release of a minor version of Kubernetes
+// 1, and whether it excludes some. Constraints only change from including to excluding patch releases at the bounds
+// they're written with, so checking the first and a late patch release, and the patch releases around each bound of
+// the minor version, checks every range of patch releases the constraint treats alike.
+func checkMinorVersion(constraint *semver.Constraints, kubeVersion string, minor int) (bool, bool) {
+	patches := []uint64{0, 999}
+	for _, bound := range constraintBoundRegex.FindAllStringSubmatch(kubeVersion, -1) {
+		if bound[1] != "1" || bound[2] != strconv.Itoa(minor) {
+			continue
+		}
+
+		patch, err := strconv.ParseUint(bound[3], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		patches = append(patches, patch, patch+1)
+		if patch > 0 {
+			patches = append(patches, patch-1)
+		}
+	}
+
+	included, excluded := false, false
+	for _, patch := range patches {
+		if constraint.Check(semver.New(1, uint64(minor), patch, "", "")) {
+			included = true
+		} else {
+			excluded = true
+		}
+	}
+
+	return included, included && excluded
+}
+
+// getKubernetesCompatibility evaluates the kubeVersion constraint of a chart against the bundled Kubernetes versions,
+// warning about versions the constraint includes which no longer serve an API version used by the chart. Charts
+// without a kubeVersion have no compatibility matrix.
+func getKubernetesCompatibility(chartDirectory string, kubeVersion string, usages []KubernetesAPIUsage) []KubernetesVersionCompatibility {
+	if kubeVersion == "" {
+		return nil
+	}
+
+	constraint, err := semver.NewConstraint(kubeVersion)
+	if err != nil {
+		log.Warnf("Invalid kubeVersion %s of chart %s: %s", kubeVersion, chartDirectory, err)
+		return nil
+	}
+
+	compatibility := make([]KubernetesVersionCompatibility, 0, len(kubernetesMinorVersions))
+	warned := make(map[string]bool)
+	for _, minor := range kubernetesMinorVersions {
+		version := KubernetesVersionCompatibility{
+			Version:        "1." + strconv.Itoa(minor),
+			RemovedAPIs:    make([]KubernetesAPIChange, 0),
+			DeprecatedAPIs: make([]KubernetesAPIChange, 0),
+		}
+
+		version.Supported, version.Partial = checkMinorVersion(constraint, kubeVersion, minor)
+
+		for _, usage := range usages {
+			deprecation, ok := findAPIDeprecation(usage)
+			if !ok || minor < deprecation.deprecatedIn {
+				continue
+			}
+
+			change := KubernetesAPIChange{KubernetesAPIUsage: usage, Replacement: deprecation.replacement}
+			if minor < deprecation.removedIn {
+				version.DeprecatedAPIs = append(version.DeprecatedAPIs, change)
+				continue
+			}
+
+			version.RemovedAPIs = append(version.RemovedAPIs, change)
+			key := usage.APIVersion + " " + usage.Kind
+			if version.Supported && !warned[key] {
+				warned[key] = true
+				log.Warnf("kubeVersion %s of chart %s includes Kubernetes %s, which no longer serves %s %s used in %s", kubeVersion, chartDirectory, version.Version, usage.APIVersion, usage.Kind, formatAPIUsagePosition(usage))
+			}
+		}
+
+		compatibility = append(compatibility, version)
+	}
+
+	return compatibility
+}
@@ -0,0 +1,73 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestParseKubernetesAPIs() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "kubernetes-apis"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.KubernetesAPIUsage{
+		{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", File: "templates/hpa.yaml", Line: 1},
+		{APIVersion: "batch/v1beta1", Kind: "CronJob", File: "templates/cronjob.yaml", Line: 1},
+		{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", File: "templates/hpa.yaml", Line: 18},
+	}, info.KubernetesAPIs)
+}
+
+func (suite *ChartParsingTestSuite) TestKubernetesCompatibility() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "kubernetes-compatibility"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	cronJob := helm.KubernetesAPIChange{
+		KubernetesAPIUsage: helm.KubernetesAPIUsage{APIVersion: "batch/v1beta1", Kind: "CronJob", File: "templates/cronjob.yaml", Line: 1},
+		Replacement:        "batch/v1",
+	}
+
+	compatibility := make(map[string]helm.KubernetesVersionCompatibility)
+	for _, version := range info.KubernetesCompatibility {
+		compatibility[version.Version] = version
+	}
+
+	suite.Equal("1.16", info.KubernetesCompatibility[0].Version)
+	suite.Equal(helm.KubernetesVersionCompatibility{Version: "1.20", RemovedAPIs: []helm.KubernetesAPIChange{}, DeprecatedAPIs: []helm.KubernetesAPIChange{}}, compatibility["1.20"])
+	suite.Equal(helm.KubernetesVersionCompatibility{Version: "1.21", Supported: true, Partial: true, RemovedAPIs: []helm.KubernetesAPIChange{}, DeprecatedAPIs: []helm.KubernetesAPIChange{cronJob}}, compatibility["1.21"])
+	suite.Equal(helm.KubernetesVersionCompatibility{Version: "1.24", Supported: true, RemovedAPIs: []helm.KubernetesAPIChange{}, DeprecatedAPIs: []helm.KubernetesAPIChange{cronJob}}, compatibility["1.24"])
+	suite.Equal(helm.KubernetesVersionCompatibility{Version: "1.25", Supported: true, RemovedAPIs: []helm.KubernetesAPIChange{cronJob}, DeprecatedAPIs: []helm.KubernetesAPIChange{}}, compatibility["1.25"])
+	suite.False(compatibility["1.26"].Supported)
+
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "includes Kubernetes 1.25, which no longer serves batch/v1beta1 CronJob used in templates/cronjob.yaml:1")
+}
+
+func (suite *ChartParsingTestSuite) TestKubernetesCompatibilityExcludedPatch() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "kubernetes-compatibility-excluded-patch"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	compatibility := make(map[string]helm.KubernetesVersionCompatibility)
+	for _, version := range info.KubernetesCompatibility {
+		compatibility[version.Version] = version
+	}
+
+	suite.True(compatibility["1.24"].Supported)
+	suite.False(compatibility["1.24"].Partial)
+	suite.True(compatibility["1.25"].Supported)
+	suite.True(compatibility["1.25"].Partial)
+	suite.True(compatibility["1.26"].Supported)
+	suite.False(compatibility["1.26"].Partial)
+}
+
+func (suite *ChartParsingTestSuite) TestKubernetesCompatibilityWithoutKubeVersion() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "kubernetes-apis"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Nil(info.KubernetesCompatibility)
+	suite.Len(info.KubernetesAPIs, 3)
+}
@@ -0,0 +1,195 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Matches either a {{/* ... */}} comment, or the opening action of a {{ define "name" }} block
+var namedTemplateTokenRegex = regexp.MustCompile("(?s)\\{\\{-?\\s*/\\*(.*?)\\*/\\s*-?\\}\\}|\\{\\{-?\\s*define\\s+[\"`]([^\"`]+)[\"`]\\s*-?\\}\\}")
+var namedTemplateParamRegex = regexp.MustCompile("^@param\\s+(\\S+)\\s+--\\s*(.*)$")
+var namedTemplateExampleRegex = regexp.MustCompile("^@example\\s*(.*)$")
+
+// NamedTemplate is a template defined with {{ define "name" }} in one of the .tpl files in the templates directory of
+// a chart, documented by the {{/* ... */}} comment directly preceding it.
+type NamedTemplate struct {
+	Name string
+
+	// Path of the file defining the template, relative to the chart directory, and the line the definition starts at
+	File string
+	Line int
+
+	Description string
+	Parameters  []NamedTemplateParameter
+
+	// Example usage of the template, from the comment lines following "@example"
+	Example string
+}
+
+// NamedTemplateParameter is a parameter of a named template, from a "@param name -- (type) description" comment line.
+type NamedTemplateParameter struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// parseNamedTemplateComment parses the comment documenting a named template. Lines up to the first annotation are
+// the description, and the lines following "@example" are kept verbatim, apart from their common indentation.
+func parseNamedTemplateComment(comment string, template *NamedTemplate) {
+	var descriptionLines []string
+	var exampleLines []string
+	var isExample bool
+
+	for _, line := range strings.Split(strings.ReplaceAll(comment, "\r\n", "\n"), "\n") {
+		trimmedLine := strings.TrimSpace(line)
+
+		if paramMatch := namedTemplateParamRegex.FindStringSubmatch(trimmedLine); len(paramMatch) > 2 {
+			isExample = false
+			parameter := NamedTemplateParameter{Name: paramMatch[1], Description: paramMatch[2]}
+			if typeMatch := valueTypeRegex.FindStringSubmatch(parameter.Description); len(typeMatch) > 2 && typeMatch[1] != "" {
+				parameter.Type = typeMatch[1]
+				parameter.Description = typeMatch[2]
+			}
+
+			template.Parameters = append(template.Parameters, parameter)
+			continue
+		}
+
+		if exampleMatch := namedTemplateExampleRegex.FindStringSubmatch(trimmedLine); len(exampleMatch) > 1 {
+			isExample = true
+			if exampleMatch[1] != "" {
+				exampleLines = append(exampleLines, exampleMatch[1])
+			}
+			continue
+		}
+
+		if isExample {
+			exampleLines = append(exampleLines, line)
+			continue
+		}
+
+		if len(template.Parameters) > 0 {
+			// Continuation lines of a parameter's description
+			if trimmedLine != "" {
+				parameter := &template.Parameters[len(template.Parameters)-1]
+				parameter.Description = strings.TrimSpace(parameter.Description + " " + trimmedLine)
+			}
+			continue
+		}
+
+		if trimmedLine != "" {
+			descriptionLines = append(descriptionLines, trimmedLine)
+		}
+	}
+
+	template.Description = strings.Join(descriptionLines, " ")
+	template.Example = trimExampleLines(exampleLines)
+}
+
+// trimExampleLines joins the lines of an example, without the leading and trailing blank lines and the indentation
+// common to all of its lines.
+func trimExampleLines(lines []string) string {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	indentation := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		lineIndentation := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indentation == -1 || lineIndentation < indentation {
+			indentation = lineIndentation
+		}
+	}
+
+	trimmedLines := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) >= indentation {
+			trimmedLines[i] = strings.TrimRight(line[indentation:], " \t")
+		}
+	}
+
+	return strings.Join(trimmedLines, "\n")
+}
+
+func parseNamedTemplatesFile(contents string, relativePath string) []NamedTemplate {
+	namedTemplates := make([]NamedTemplate, 0)
+	matches := namedTemplateTokenRegex.FindAllStringSubmatchIndex(contents, -1)
+
+	for i, match := range matches {
+		// Only define actions are templates, comments are looked at from the define following them
+		if match[4] == -1 {
+			continue
+		}
+
+		namedTemplate := NamedTemplate{
+			Name: contents[match[4]:match[5]],
+			File: relativePath,
+			Line: strings.Count(contents[:match[0]], "\n") + 1,
+		}
+
+		if i > 0 {
+			previous := matches[i-1]
+			if previous[2] != -1 && strings.TrimSpace(contents[previous[1]:match[0]]) == "" {
+				parseNamedTemplateComment(contents[previous[2]:previous[3]], &namedTemplate)
+			}
+		}
+
+		namedTemplates = append(namedTemplates, namedTemplate)
+	}
+
+	return namedTemplates
+}
+
+// parseNamedTemplates parses the templates defined in the .tpl files of the templates directory of a chart, sorted by
+// name. Templates defined more than once are reported as warnings. Helm parses the files in reverse order of their paths
+// and each file overrides the definitions of the files parsed before it, so the definition in the first file is used.
+func parseNamedTemplates(chartDirectory string) []NamedTemplate {
+	namedTemplatesByName := make(map[string]NamedTemplate)
+	templateFiles, err := filepath.Glob(filepath.Join(chartDirectory, "templates", "*.tpl"))
+	if err != nil {
+		log.Warnf("Failed to find the named templates of chart %s: %s", chartDirectory, err)
+	}
+
+	// Glob sorts the files, Helm parses them the other way round
+	sort.Sort(sort.Reverse(sort.StringSlice(templateFiles)))
+	for _, templateFile := range templateFiles {
+		contents, err := os.ReadFile(templateFile)
+		if err != nil {
+			log.Warnf("Failed to read template file %s: %s", templateFile, err)
+			continue
+		}
+
+		relativePath, _ := filepath.Rel(chartDirectory, templateFile)
+		for _, namedTemplate := range parseNamedTemplatesFile(string(contents), filepath.ToSlash(relativePath)) {
+			if previous, ok := namedTemplatesByName[namedTemplate.Name]; ok {
+				log.Warnf("Template %s defined in %s:%d overrides its definition in %s:%d of chart %s", namedTemplate.Name, namedTemplate.File, namedTemplate.Line, previous.File, previous.Line, chartDirectory)
+			}
+
+			namedTemplatesByName[namedTemplate.Name] = namedTemplate
+		}
+	}
+
+	namedTemplates := make([]NamedTemplate, 0, len(namedTemplatesByName))
+	for _, namedTemplate := range namedTemplatesByName {
+		namedTemplates = append(namedTemplates, namedTemplate)
+	}
+
+	sort.Slice(namedTemplates, func(i, j int) bool {
+		return namedTemplates[i].Name < namedTemplates[j].Name
+	})
+
+	return namedTemplates
+}
@@ -0,0 +1,53 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestParseNamedTemplates() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "named-templates"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.NamedTemplate{
+		{
+			Name:        "lib.labels",
+			File:        "templates/_helpers.tpl",
+			Line:        21,
+			Description: "Common labels.",
+			Parameters: []helm.NamedTemplateParameter{
+				{Name: "context", Type: "context", Description: "The context of the chart"},
+				{Name: "component", Description: "Name of the component"},
+			},
+			Example: "metadata:\n  labels:\n    {{- include \"lib.labels\" (dict \"context\" . \"component\" \"server\") | nindent 4 }}",
+		},
+		{
+			Name:        "lib.name",
+			File:        "templates/_helpers.tpl",
+			Line:        7,
+			Description: "Expand the name of the chart.",
+		},
+		{
+			Name: "lib.undocumented",
+			File: "templates/_helpers.tpl",
+			Line: 27,
+		},
+	}, info.NamedTemplates)
+}
+
+func (suite *ChartParsingTestSuite) TestRedefinedNamedTemplate() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "redefined-named-template"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(info.NamedTemplates, 1)
+	suite.Equal("First", info.NamedTemplates[0].Description)
+	suite.Equal("templates/_a.tpl", info.NamedTemplates[0].File)
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "Template lib.name defined in templates/_a.tpl:2 overrides its definition in templates/_b.tpl:2")
+}
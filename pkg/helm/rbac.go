@@ -0,0 +1,159 @@
+package helm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RBACRule is a rule of a Role or ClusterRole, granting the verbs on the resources of the API groups.
+type RBACRule struct {
+	APIGroups       []string `yaml:"apiGroups"`
+	Resources       []string `yaml:"resources"`
+	ResourceNames   []string `yaml:"resourceNames"`
+	NonResourceURLs []string `yaml:"nonResourceURLs"`
+	Verbs           []string `yaml:"verbs"`
+}
+
+type RBACRoleRef struct {
+	APIGroup string `yaml:"apiGroup"`
+	Kind     string `yaml:"kind"`
+	Name     string `yaml:"name"`
+}
+
+type RBACSubject struct {
+	Kind      string `yaml:"kind"`
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace"`
+}
+
+// RBACRole is a Role or ClusterRole the chart creates with its default values.
+type RBACRole struct {
+	Kind  string
+	Name  string
+	Rules []RBACRule
+
+	// Path of the template creating the role, relative to the chart directory
+	File string
+
+	// Keys of the values the blocks of the template enclosing the role depend on, e.g. rbac.create
+	Toggles []string
+}
+
+// RBACBinding is a RoleBinding or ClusterRoleBinding the chart creates with its default values.
+type RBACBinding struct {
+	Kind     string
+	Name     string
+	RoleRef  RBACRoleRef
+	Subjects []RBACSubject
+
+	// Path of the template creating the binding, relative to the chart directory
+	File string
+
+	// Keys of the values the blocks of the template enclosing the binding depend on, e.g. rbac.create
+	Toggles []string
+}
+
+// ChartRBAC is the permissions a chart grants with its default values.
+type ChartRBAC struct {
+	Roles    []RBACRole
+	Bindings []RBACBinding
+}
+
+// rbacTemplateResource is a role or binding written out in a template, with the keys of the values the if and with
+// blocks enclosing it are conditional on.
+type rbacTemplateResource struct {
+	kind string
+
+	// Matches the names the resource is rendered with, nil if the template writes out no name
+	name    *regexp.Regexp
+	toggles []string
+}
+
+func isRBACManifest(manifest renderedManifest) bool {
+	return strings.HasPrefix(manifest.APIVersion, "rbac.authorization.k8s.io/")
+}
+
+// getRBACToggles returns the values a rendered role or binding depends on, those of the first resource of its kind in
+// its template whose name it could be rendered with.
+func getRBACToggles(manifest renderedManifest, rbacResources map[string][]rbacTemplateResource) []string {
+	for _, resource := range rbacResources[manifest.file] {
+		if resource.kind == manifest.Kind && (resource.name == nil || resource.name.MatchString(manifest.Metadata.Name)) {
+			return resource.toggles
+		}
+	}
+
+	return nil
+}
+
+// getChartRBAC returns the roles and bindings among the rendered resources of a chart, with the values the blocks of
+// the templates enclosing them depend on.
+func getChartRBAC(manifests []renderedManifest, rbacResources map[string][]rbacTemplateResource) ChartRBAC {
+	rbac := ChartRBAC{Roles: make([]RBACRole, 0), Bindings: make([]RBACBinding, 0)}
+	for _, manifest := range manifests {
+		if !isRBACManifest(manifest) {
+			continue
+		}
+
+		switch manifest.Kind {
+		case "Role", "ClusterRole":
+			rbac.Roles = append(rbac.Roles, RBACRole{
+				Kind:    manifest.Kind,
+				Name:    manifest.Metadata.Name,
+				Rules:   manifest.Rules,
+				File:    manifest.file,
+				Toggles: getRBACToggles(manifest, rbacResources),
+			})
+		case "RoleBinding", "ClusterRoleBinding":
+			rbac.Bindings = append(rbac.Bindings, RBACBinding{
+				Kind:     manifest.Kind,
+				Name:     manifest.Metadata.Name,
+				RoleRef:  manifest.RoleRef,
+				Subjects: manifest.Subjects,
+				File:     manifest.file,
+				Toggles:  getRBACToggles(manifest, rbacResources),
+			})
+		}
+	}
+
+	return rbac
+}
+
+func filterWildcards(items []string) []string {
+	wildcards := make([]string, 0)
+	for _, item := range items {
+		if strings.Contains(item, "*") {
+			wildcards = append(wildcards, item)
+		}
+	}
+
+	return wildcards
+}
+
+// checkRBACWildcards fails if any of the roles of a chart grants wildcard verbs or resources, which grant permissions
+// added to the cluster later on too.
+func checkRBACWildcards(rbac ChartRBAC) error {
+	wildcardRules := make([]string, 0)
+	for _, role := range rbac.Roles {
+		for _, rule := range role.Rules {
+			var wildcards []string
+			if verbs := filterWildcards(rule.Verbs); len(verbs) > 0 {
+				wildcards = append(wildcards, fmt.Sprintf("verbs %s", strings.Join(verbs, ", ")))
+			}
+
+			if resources := filterWildcards(rule.Resources); len(resources) > 0 {
+				wildcards = append(wildcards, fmt.Sprintf("resources %s", strings.Join(resources, ", ")))
+			}
+
+			if len(wildcards) > 0 {
+				wildcardRules = append(wildcardRules, fmt.Sprintf("%s %s (%s): %s", role.Kind, role.Name, role.File, strings.Join(wildcards, ", ")))
+			}
+		}
+	}
+
+	if len(wildcardRules) > 0 {
+		return fmt.Errorf("roles granting wildcard verbs or resources: \n%s", strings.Join(wildcardRules, "\n"))
+	}
+
+	return nil
+}
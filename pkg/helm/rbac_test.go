@@ -0,0 +1,75 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestParseRBAC() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rbac"), helm.ChartValuesDocumentationParsingConfig{StrictRBACWildcards: true})
+	suite.Require().NoError(err)
+
+	suite.Equal(helm.ChartRBAC{
+		Roles: []helm.RBACRole{
+			{
+				Kind:    "Role",
+				Name:    "release-name-leader-election",
+				Rules:   []helm.RBACRule{{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"get", "create", "update"}}},
+				File:    "templates/leader-election.yaml",
+				Toggles: []string{"rbac.create"},
+			},
+			{
+				Kind:    "Role",
+				Name:    "release-name-metrics",
+				Rules:   []helm.RBACRule{{APIGroups: []string{""}, Resources: []string{"endpoints"}, Verbs: []string{"get"}}},
+				File:    "templates/leader-election.yaml",
+				Toggles: []string{"metrics.enabled", "rbac.create"},
+			},
+			{
+				Kind: "ClusterRole",
+				Name: "release-name-reader",
+				Rules: []helm.RBACRule{
+					{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list", "watch"}},
+					{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+				},
+				File:    "templates/rbac.yaml",
+				Toggles: []string{"rbac.create"},
+			},
+		},
+		Bindings: []helm.RBACBinding{
+			{
+				Kind:     "ClusterRoleBinding",
+				Name:     "release-name-reader",
+				RoleRef:  helm.RBACRoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "release-name-reader"},
+				Subjects: []helm.RBACSubject{{Kind: "ServiceAccount", Name: "release-name", Namespace: "default"}},
+				File:     "templates/rbac.yaml",
+				Toggles:  []string{"rbac.create"},
+			},
+		},
+	}, info.RBAC)
+}
+
+func (suite *ChartParsingTestSuite) TestCheckRBACWildcards() {
+	_, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rbac-wildcards"), helm.ChartValuesDocumentationParsingConfig{StrictRBACWildcards: true})
+
+	suite.Require().Error(err)
+	suite.Equal("roles granting wildcard verbs or resources: \n"+
+		"Role release-name-admin (templates/admin.yaml): verbs *\n"+
+		"Role release-name-admin (templates/admin.yaml): resources *", err.Error())
+}
+
+func (suite *ChartParsingTestSuite) TestCheckRBACWildcardsDisabled() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rbac-wildcards"), helm.ChartValuesDocumentationParsingConfig{})
+
+	suite.Require().NoError(err)
+	suite.Empty(info.RBAC.Roles)
+}
+
+func (suite *ChartParsingTestSuite) TestCheckRBACWildcardsRenderFailure() {
+	_, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rbac-render-failure"), helm.ChartValuesDocumentationParsingConfig{StrictRBACWildcards: true})
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "failed to render the chart with its default values")
+	suite.Contains(err.Error(), "a token is required")
+}
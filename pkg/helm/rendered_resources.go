@@ -0,0 +1,219 @@
+package helm
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/releaseutil"
+)
+
+// RenderedReleaseName is the name of the release charts are rendered with, which shows where resource names are derived
+// from it.
+const RenderedReleaseName = "release-name"
+
+// The kinds of the built-in resources which aren't namespaced, keyed by their API group
+var clusterScopedKinds = map[string][]string{
+	"":                             {"ComponentStatus", "Namespace", "Node", "PersistentVolume"},
+	"admissionregistration.k8s.io": {"MutatingWebhookConfiguration", "ValidatingAdmissionPolicy", "ValidatingAdmissionPolicyBinding", "ValidatingWebhookConfiguration"},
+	"apiextensions.k8s.io":         {"CustomResourceDefinition"},
+	"apiregistration.k8s.io":       {"APIService"},
+	"certificates.k8s.io":          {"CertificateSigningRequest"},
+	"flowcontrol.apiserver.k8s.io": {"FlowSchema", "PriorityLevelConfiguration"},
+	"networking.k8s.io":            {"IngressClass"},
+	"node.k8s.io":                  {"RuntimeClass"},
+	"policy":                       {"PodSecurityPolicy"},
+	"rbac.authorization.k8s.io":    {"ClusterRole", "ClusterRoleBinding"},
+	"scheduling.k8s.io":            {"PriorityClass"},
+	"storage.k8s.io":               {"CSIDriver", "CSINode", "StorageClass", "VolumeAttachment"},
+}
+
+// RenderedResource is a Kubernetes resource produced by rendering the templates of a chart with its default values.
+type RenderedResource struct {
+	APIVersion string
+	Kind       string
+
+	// Name of the resource as rendered with the release name release-name
+	Name string
+
+	// Namespace set in the manifest, empty for resources created in the namespace of the release
+	Namespace  string
+	Namespaced bool
+
+	// Events of the helm.sh/hook annotation, empty for resources which aren't hooks
+	Hook string
+
+	// Path of the template producing the resource, relative to the chart directory. Templates of dependencies are
+	// below their charts/ directory.
+	File string
+}
+
+// renderedManifest is a resource rendered from the templates of a chart, with the fields helm-docs documents.
+type renderedManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+
+	// Fields of RBAC roles and bindings
+	Rules    []RBACRule    `yaml:"rules"`
+	RoleRef  RBACRoleRef   `yaml:"roleRef"`
+	Subjects []RBACSubject `yaml:"subjects"`
+
+	// Path of the template the resource is rendered from, relative to the chart directory
+	file string
+}
+
+// isNamespacedKind returns whether resources of a kind are namespaced, which custom resources are unless the chart
+// defines them as cluster scoped in its crds directory.
+func isNamespacedKind(apiVersion string, kind string, crds []CustomResourceDefinition) bool {
+	group := ""
+	if i := strings.LastIndex(apiVersion, "/"); i != -1 {
+		group = apiVersion[:i]
+	}
+
+	for _, clusterScopedKind := range clusterScopedKinds[group] {
+		if clusterScopedKind == kind {
+			return false
+		}
+	}
+
+	for _, crd := range crds {
+		if crd.Group == group && crd.Kind == kind {
+			return crd.Scope != "Cluster"
+		}
+	}
+
+	return true
+}
+
+// getRenderCapabilities returns the capabilities of the cluster charts are rendered for, Helm's defaults with the
+// Kubernetes version and the additional API versions set with --kube-version and --api-versions.
+func getRenderCapabilities() (*chartutil.Capabilities, error) {
+	capabilities := chartutil.DefaultCapabilities.Copy()
+	if kubeVersion := viper.GetString("kube-version"); kubeVersion != "" {
+		version, err := chartutil.ParseKubeVersion(kubeVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kube version %s: %w", kubeVersion, err)
+		}
+
+		capabilities.KubeVersion = *version
+	}
+
+	capabilities.APIVersions = append(capabilities.APIVersions, viper.GetStringSlice("api-versions")...)
+	return capabilities, nil
+}
+
+// renderChart renders the templates of a chart offline with the values of its values file the way helm template does,
+// returning the rendered files keyed by their path relative to the chart directory.
+func renderChart(chartDirectory string) (map[string]string, error) {
+	chart, err := loader.Load(chartDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := chartutil.ReadValuesFile(filepath.Join(chartDirectory, viper.GetString("values-file")))
+	if err != nil {
+		return nil, err
+	}
+	chart.Values = values
+
+	capabilities, err := getRenderCapabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := chartutil.ProcessDependencies(chart, chartutil.Values{}); err != nil {
+		return nil, err
+	}
+
+	releaseOptions := chartutil.ReleaseOptions{Name: RenderedReleaseName, Namespace: "default", Revision: 1, IsInstall: true}
+	renderValues, err := chartutil.ToRenderValues(chart, map[string]interface{}{}, releaseOptions, capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := engine.Render(chart, renderValues)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string, len(rendered))
+	for name, contents := range rendered {
+		files[strings.TrimPrefix(name, chart.Name()+"/")] = contents
+	}
+
+	return files, nil
+}
+
+// parseRenderedManifests renders a chart with the values of its values file and returns the resources it produces, in
+// the order of the templates producing them and, within a template, the order of its documents.
+func parseRenderedManifests(chartDirectory string) ([]renderedManifest, error) {
+	manifests := make([]renderedManifest, 0)
+	files, err := renderChart(chartDirectory)
+	if err != nil {
+		return manifests, fmt.Errorf("failed to render the chart with its default values: %w", err)
+	}
+
+	fileNames := make([]string, 0, len(files))
+	for name := range files {
+		if path.Base(name) != "NOTES.txt" {
+			fileNames = append(fileNames, name)
+		}
+	}
+	sort.Strings(fileNames)
+
+	for _, name := range fileNames {
+		documents := releaseutil.SplitManifests(files[name])
+		keys := make([]string, 0, len(documents))
+		for key := range documents {
+			keys = append(keys, key)
+		}
+		sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+		for _, key := range keys {
+			var manifest renderedManifest
+			if err := yaml.Unmarshal([]byte(documents[key]), &manifest); err != nil {
+				log.Warnf("Failed to parse a manifest rendered from %s of chart %s: %s", name, chartDirectory, err)
+				continue
+			}
+
+			if manifest.Kind == "" {
+				continue
+			}
+
+			manifest.file = name
+			manifests = append(manifests, manifest)
+		}
+	}
+
+	return manifests, nil
+}
+
+func getRenderedResources(manifests []renderedManifest, crds []CustomResourceDefinition) []RenderedResource {
+	resources := make([]RenderedResource, 0, len(manifests))
+	for _, manifest := range manifests {
+		resources = append(resources, RenderedResource{
+			APIVersion: manifest.APIVersion,
+			Kind:       manifest.Kind,
+			Name:       manifest.Metadata.Name,
+			Namespace:  manifest.Metadata.Namespace,
+			Namespaced: isNamespacedKind(manifest.APIVersion, manifest.Kind, crds),
+			Hook:       manifest.Metadata.Annotations["helm.sh/hook"],
+			File:       manifest.file,
+		})
+	}
+
+	return resources
+}
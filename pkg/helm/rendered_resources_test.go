@@ -0,0 +1,71 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/viper"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestParseRenderedResources() {
+	viper.Set("render-resources", true)
+	viper.Set("api-versions", []string{"monitoring.coreos.com/v1"})
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rendered-resources"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.RenderedResource{
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "release-name-app", Namespaced: true, File: "templates/deployment.yaml"},
+		{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "release-name-reader", File: "templates/deployment.yaml"},
+		{APIVersion: "monitoring.coreos.com/v1", Kind: "ServiceMonitor", Name: "release-name-app", Namespace: "monitoring", Namespaced: true, File: "templates/deployment.yaml"},
+		{APIVersion: "batch/v1", Kind: "Job", Name: "release-name-migrate", Namespaced: true, Hook: "pre-install,pre-upgrade", File: "templates/hooks.yaml"},
+		{APIVersion: "example.com/v1", Kind: "Cluster", Name: "release-name", File: "templates/hooks.yaml"},
+		{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget", Name: "release-name-app", Namespaced: true, File: "templates/pdb.yaml"},
+	}, info.RenderedResources)
+}
+
+func (suite *ChartParsingTestSuite) TestParseRenderedResourcesDisabled() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rendered-resources"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+	suite.Empty(info.RenderedResources)
+}
+
+func (suite *ChartParsingTestSuite) TestParseRenderedResourcesValuesFile() {
+	viper.Set("render-resources", true)
+	viper.Set("values-file", "values-without-rbac.yaml")
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rendered-resources"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.RenderedResource{
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "release-name-app", Namespaced: true, File: "templates/deployment.yaml"},
+		{APIVersion: "batch/v1", Kind: "Job", Name: "release-name-migrate", Namespaced: true, Hook: "pre-install,pre-upgrade", File: "templates/hooks.yaml"},
+		{APIVersion: "example.com/v1", Kind: "Cluster", Name: "release-name", File: "templates/hooks.yaml"},
+		{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget", Name: "release-name-app", Namespaced: true, File: "templates/pdb.yaml"},
+	}, info.RenderedResources)
+}
+
+func (suite *ChartParsingTestSuite) TestParseRenderedResourcesKubeVersion() {
+	viper.Set("render-resources", true)
+	viper.Set("kube-version", "1.29.0")
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "rendered-resources"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(info.RenderedResources, 5)
+	suite.Equal(helm.RenderedResource{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", Name: "release-name-app", Namespaced: true, File: "templates/pdb.yaml"}, info.RenderedResources[4])
+}
+
+func (suite *ChartParsingTestSuite) TestParseRenderedResourcesFailure() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	viper.Set("render-resources", true)
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "unrenderable-chart"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+	suite.Empty(info.RenderedResources)
+	suite.Contains(hook.LastEntry().Message, "a token is required")
+}
@@ -0,0 +1,692 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template/parse"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Match the literal apiVersion and kind fields of resources in the text of a template, which unlike those of nested
+// objects, e.g. the scaleTargetRef of a HorizontalPodAutoscaler, aren't indented
+var apiVersionFieldRegex = regexp.MustCompile(`(?m)^apiVersion:[ \t]*["']?([\w.\-]+(?:/[\w.\-]+)?)["']?[ \t]*$`)
+var kindFieldRegex = regexp.MustCompile(`(?m)^(?:kind:[ \t]*["']?(\w+)["']?[ \t]*$|---)`)
+var documentSeparatorRegex = regexp.MustCompile(`(?m)^---`)
+
+// Matches the kind of a Role, ClusterRole, RoleBinding or ClusterRoleBinding in the text of a template
+var rbacKindRegex = regexp.MustCompile(`(?m)^[ \t]*kind:[ \t]*["']?((?:Cluster)?Role(?:Binding)?)["']?[ \t]*$`)
+
+// Matches the name field of the metadata of a resource in the text of a template, and the actions of a template
+var metadataNameRegex = regexp.MustCompile(`(?m)^metadata:[ \t]*\n(?:[ \t]+.*\n)*?[ \t]+name:[ \t]*(.*?)[ \t]*$`)
+var templateActionRegex = regexp.MustCompile(`{{.*?}}`)
+
+// ValueUsage is a reference to a value in one of the templates of a chart.
+type ValueUsage struct {
+	// Key of the value in the format of the values table, with [] for any item of a list or map ranged over, e.g.
+	// ingress.hosts[].host
+	Key string
+
+	// Path of the template, relative to the chart directory, and the line the value is referenced at
+	File string
+	Line int
+
+	// Whether the value is the pipeline of a with or range block, which uses the value itself but not necessarily the
+	// values nested in it, since those the block uses are referenced through its dot
+	Block bool
+}
+
+// RequiredValue is a value the templates of a chart fail to render without, either because it's passed to required or
+// because a fail call is guarded by a condition on it.
+type RequiredValue struct {
+	// Key of the value in the format of the values table
+	Key string
+
+	// Message the template fails with, empty if it isn't a literal string
+	Message string
+
+	// Path of the template, relative to the chart directory, and the line the value is required at
+	File string
+	Line int
+}
+
+// KubernetesAPIUsage is an API version and kind of Kubernetes resources a template of a chart creates.
+type KubernetesAPIUsage struct {
+	APIVersion string
+	Kind       string
+
+	// Path of the template, relative to the chart directory, and the line of its apiVersion field
+	File string
+	Line int
+}
+
+// templateReference is what an expression in a template statically resolves to, either the root context of the
+// chart or a value below .Values. A nil reference can't be resolved.
+type templateReference struct {
+	values   bool
+	segments []string
+}
+
+func (r *templateReference) withSegments(segments ...string) *templateReference {
+	if r == nil || !r.values {
+		return nil
+	}
+
+	return &templateReference{values: true, segments: append(append([]string{}, r.segments...), segments...)}
+}
+
+func (r *templateReference) key() string {
+	return JoinValueKey(r.segments)
+}
+
+// formatValueKeySegment quotes keys containing dots or spaces the same way the keys of the values table are quoted.
+func formatValueKeySegment(key string) string {
+	if strings.Contains(key, ".") || strings.Contains(key, " ") {
+		return fmt.Sprintf(`"%s"`, key)
+	}
+
+	return key
+}
+
+// templateScope is the dot and the variables in scope at a point of a template.
+type templateScope struct {
+	dot       *templateReference
+	variables map[string]*templateReference
+}
+
+func (s templateScope) nested(dot *templateReference) templateScope {
+	variables := make(map[string]*templateReference, len(s.variables))
+	for name, reference := range s.variables {
+		variables[name] = reference
+	}
+
+	return templateScope{dot: dot, variables: variables}
+}
+
+type templateUsageScanner struct {
+	file           string
+	contents       string
+	usages         []ValueUsage
+	requiredValues []RequiredValue
+
+	// Keys of the values the if and with blocks enclosing the node being scanned are conditional on
+	conditions []string
+
+	// Roles and bindings written out in the template, in the order they're written in
+	rbacResources []rbacTemplateResource
+
+	// Whether the node being scanned is in a block conditional on .Capabilities, whose API versions only apply to some
+	// clusters
+	capabilitiesConditional bool
+
+	// API versions written out in the template, outside blocks conditional on .Capabilities
+	apiUsages []KubernetesAPIUsage
+}
+
+// conditionKeys returns the keys of the values referenced in the pipeline of an if or with block.
+func (s *templateUsageScanner) conditionKeys(pipe *parse.PipeNode, scope templateScope) []string {
+	conditionScanner := &templateUsageScanner{file: s.file, contents: s.contents}
+	conditionScanner.scanPipe(pipe, scope)
+
+	keys := make([]string, 0, len(conditionScanner.usages))
+	for _, usage := range conditionScanner.usages {
+		keys = append(keys, usage.Key)
+	}
+
+	return keys
+}
+
+// scanConditionalList scans the list of an if or with block, which is conditional on the values of its pipeline.
+func (s *templateUsageScanner) scanConditionalList(list *parse.ListNode, scope templateScope, pipe *parse.PipeNode, conditions []string) {
+	enclosingConditions := s.conditions
+	enclosingCapabilitiesConditional := s.capabilitiesConditional
+	s.conditions = append(append([]string{}, enclosingConditions...), conditions...)
+	s.capabilitiesConditional = s.capabilitiesConditional || strings.Contains(pipe.String(), ".Capabilities")
+	s.scanList(list, scope)
+	s.conditions = enclosingConditions
+	s.capabilitiesConditional = enclosingCapabilitiesConditional
+}
+
+// scanAPIVersions records the literal apiVersion fields of a text node, along with the kind of the document each of them
+// is in, if it's literal too.
+func (s *templateUsageScanner) scanAPIVersions(node *parse.TextNode) {
+	if s.capabilitiesConditional {
+		return
+	}
+
+	for _, match := range apiVersionFieldRegex.FindAllSubmatchIndex(node.Text, -1) {
+		offset := int(node.Position()) + match[1]
+		usage := KubernetesAPIUsage{
+			APIVersion: string(node.Text[match[2]:match[3]]),
+			File:       s.file,
+			Line:       strings.Count(s.contents[:int(node.Position())+match[2]], "\n") + 1,
+		}
+
+		usage.Kind = s.documentKind(int(node.Position())+match[0], offset)
+
+		s.apiUsages = append(s.apiUsages, usage)
+	}
+}
+
+// documentKind returns the literal kind field of the document containing the field between the offsets, searching after
+// the field up to the next document separator first, then before it up to the previous one.
+func (s *templateUsageScanner) documentKind(start int, end int) string {
+	if kindMatch := kindFieldRegex.FindStringSubmatch(s.contents[end:]); len(kindMatch) > 1 && kindMatch[1] != "" {
+		return kindMatch[1]
+	}
+
+	document := s.contents[:start]
+	if separators := documentSeparatorRegex.FindAllStringIndex(document, -1); len(separators) > 0 {
+		document = document[separators[len(separators)-1][1]:]
+	}
+
+	kind := ""
+	for _, kindMatch := range kindFieldRegex.FindAllStringSubmatch(document, -1) {
+		kind = kindMatch[1]
+	}
+
+	return kind
+}
+
+// documentName returns a regular expression matching the names the document containing the offset is rendered with,
+// the literal parts of the name in its metadata with any text in place of its actions, or nil if it has no name field.
+func (s *templateUsageScanner) documentName(offset int) *regexp.Regexp {
+	start := 0
+	if separators := documentSeparatorRegex.FindAllStringIndex(s.contents[:offset], -1); len(separators) > 0 {
+		start = separators[len(separators)-1][1]
+	}
+
+	end := len(s.contents)
+	if separator := documentSeparatorRegex.FindStringIndex(s.contents[offset:]); separator != nil {
+		end = offset + separator[0]
+	}
+
+	nameMatch := metadataNameRegex.FindStringSubmatch(s.contents[start:end])
+	if nameMatch == nil {
+		return nil
+	}
+
+	name := strings.Trim(nameMatch[1], `"'`)
+	literals := templateActionRegex.Split(name, -1)
+	for i, literal := range literals {
+		literals[i] = regexp.QuoteMeta(literal)
+	}
+
+	return regexp.MustCompile("^" + strings.Join(literals, ".*") + "$")
+}
+
+// scanRBACResources records the roles and bindings written out in a text node, with the values the blocks enclosing
+// them are conditional on.
+func (s *templateUsageScanner) scanRBACResources(node *parse.TextNode) {
+	for _, match := range rbacKindRegex.FindAllSubmatchIndex(node.Text, -1) {
+		var toggles []string
+		toggled := make(map[string]bool)
+		for _, key := range s.conditions {
+			if !toggled[key] {
+				toggled[key] = true
+				toggles = append(toggles, key)
+			}
+		}
+		sort.Strings(toggles)
+
+		s.rbacResources = append(s.rbacResources, rbacTemplateResource{
+			kind:    string(node.Text[match[2]:match[3]]),
+			name:    s.documentName(int(node.Position()) + match[0]),
+			toggles: toggles,
+		})
+	}
+}
+
+func (s *templateUsageScanner) line(node parse.Node) int {
+	return strings.Count(s.contents[:node.Position()], "\n") + 1
+}
+
+func (s *templateUsageScanner) record(reference *templateReference, node parse.Node, block bool) {
+	if reference == nil || !reference.values || len(reference.segments) == 0 {
+		return
+	}
+
+	s.usages = append(s.usages, ValueUsage{
+		Key:   reference.key(),
+		File:  s.file,
+		Line:  s.line(node),
+		Block: block,
+	})
+}
+
+func (s *templateUsageScanner) recordRequired(reference *templateReference, message string, node parse.Node) {
+	if reference == nil || !reference.values || len(reference.segments) == 0 {
+		return
+	}
+
+	s.requiredValues = append(s.requiredValues, RequiredValue{
+		Key:     reference.key(),
+		Message: message,
+		File:    s.file,
+		Line:    s.line(node),
+	})
+}
+
+// stringArg returns the text of a literal string argument, or an empty string for any other argument.
+func stringArg(node parse.Node) string {
+	if text, ok := node.(*parse.StringNode); ok {
+		return text.Text
+	}
+
+	return ""
+}
+
+func isFunctionCall(command *parse.CommandNode, name string) bool {
+	function, ok := command.Args[0].(*parse.IdentifierNode)
+	return ok && function.Ident == name
+}
+
+// scanRequired records the values passed to required, either as its last argument or piped into it.
+func (s *templateUsageScanner) scanRequired(pipe *parse.PipeNode, scope templateScope) {
+	for i, command := range pipe.Cmds {
+		if !isFunctionCall(command, "required") {
+			continue
+		}
+
+		switch {
+		case len(command.Args) == 3:
+			s.recordRequired(resolveNode(command.Args[2], scope), stringArg(command.Args[1]), command)
+		case len(command.Args) == 2 && i > 0:
+			s.recordRequired(resolvePipe(&parse.PipeNode{Cmds: pipe.Cmds[:i]}, scope), stringArg(command.Args[1]), command)
+		}
+	}
+}
+
+// findFail returns the message of the first fail call among the actions of a list, or false if there's none.
+func findFail(list *parse.ListNode) (string, bool) {
+	if list == nil {
+		return "", false
+	}
+
+	for _, node := range list.Nodes {
+		action, ok := node.(*parse.ActionNode)
+		if !ok || len(action.Pipe.Cmds) != 1 || !isFunctionCall(action.Pipe.Cmds[0], "fail") {
+			continue
+		}
+
+		if len(action.Pipe.Cmds[0].Args) > 1 {
+			return stringArg(action.Pipe.Cmds[0].Args[1]), true
+		}
+
+		return "", true
+	}
+
+	return "", false
+}
+
+// scanFailGuard records the value an if block fails without, which is the case for {{ if not .Values.x }} and
+// {{ if empty .Values.x }} blocks calling fail, and for {{ if .Values.x }} blocks calling fail in their else branch.
+func (s *templateUsageScanner) scanFailGuard(node *parse.IfNode, scope templateScope) {
+	if len(node.Pipe.Cmds) != 1 || len(node.Pipe.Decl) > 0 {
+		return
+	}
+
+	command := node.Pipe.Cmds[0]
+	if len(command.Args) == 2 && (isFunctionCall(command, "not") || isFunctionCall(command, "empty")) {
+		if message, ok := findFail(node.List); ok {
+			s.recordRequired(resolveNode(command.Args[1], scope), message, node)
+		}
+		return
+	}
+
+	if len(command.Args) == 1 {
+		if message, ok := findFail(node.ElseList); ok {
+			s.recordRequired(resolveNode(command.Args[0], scope), message, node)
+		}
+	}
+}
+
+// resolveFields applies field accesses to a reference. .Values of the root context refers to the values, any other
+// field of it, such as .Release or .Chart, can't be resolved.
+func resolveFields(reference *templateReference, fields []string) *templateReference {
+	for _, field := range fields {
+		if reference == nil {
+			return nil
+		}
+
+		if !reference.values {
+			if field != "Values" {
+				return nil
+			}
+
+			reference = &templateReference{values: true}
+			continue
+		}
+
+		reference = reference.withSegments(formatValueKeySegment(field))
+	}
+
+	return reference
+}
+
+func resolveNode(node parse.Node, scope templateScope) *templateReference {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return scope.dot
+	case *parse.FieldNode:
+		return resolveFields(scope.dot, n.Ident)
+	case *parse.VariableNode:
+		return resolveFields(scope.variables[n.Ident[0]], n.Ident[1:])
+	case *parse.ChainNode:
+		return resolveFields(resolveNode(n.Node, scope), n.Field)
+	case *parse.PipeNode:
+		return resolvePipe(n, scope)
+	}
+
+	return nil
+}
+
+// resolveCommand resolves a command consisting of a single reference, or an index, get, default or required call on a
+// reference with literal keys, which returns the value referenced.
+func resolveCommand(command *parse.CommandNode, scope templateScope) *templateReference {
+	if len(command.Args) == 1 {
+		return resolveNode(command.Args[0], scope)
+	}
+
+	function, ok := command.Args[0].(*parse.IdentifierNode)
+	if !ok {
+		return nil
+	}
+
+	switch function.Ident {
+	case "index", "get":
+		reference := resolveNode(command.Args[1], scope)
+		for _, arg := range command.Args[2:] {
+			switch key := arg.(type) {
+			case *parse.StringNode:
+				reference = reference.withSegments(formatValueKeySegment(key.Text))
+			case *parse.NumberNode:
+				if !key.IsInt {
+					return nil
+				}
+				reference = reference.withSegments(fmt.Sprintf("[%d]", key.Int64))
+			default:
+				return nil
+			}
+		}
+
+		return reference
+	case "default", "required":
+		if len(command.Args) == 3 {
+			return resolveNode(command.Args[2], scope)
+		}
+	}
+
+	return nil
+}
+
+// resolvePipe resolves a pipeline whose first command is a reference, optionally piped into default or required.
+func resolvePipe(pipe *parse.PipeNode, scope templateScope) *templateReference {
+	if pipe == nil || len(pipe.Cmds) == 0 {
+		return nil
+	}
+
+	for _, command := range pipe.Cmds[1:] {
+		function, ok := command.Args[0].(*parse.IdentifierNode)
+		if !ok || (function.Ident != "default" && function.Ident != "required") {
+			return nil
+		}
+	}
+
+	return resolveCommand(pipe.Cmds[0], scope)
+}
+
+// scanArg records the value an argument resolves to, or the values referenced within it if it can't be resolved.
+func (s *templateUsageScanner) scanArg(node parse.Node, scope templateScope) {
+	switch n := node.(type) {
+	case *parse.PipeNode:
+		s.scanPipe(n, scope)
+	case *parse.ChainNode:
+		if reference := resolveNode(n, scope); reference != nil {
+			s.record(reference, n, false)
+		} else {
+			s.scanArg(n.Node, scope)
+		}
+	case *parse.DotNode, *parse.FieldNode, *parse.VariableNode:
+		s.record(resolveNode(n, scope), n, false)
+	}
+}
+
+func (s *templateUsageScanner) scanCommand(command *parse.CommandNode, scope templateScope) {
+	if reference := resolveCommand(command, scope); reference != nil {
+		s.record(reference, command, false)
+		return
+	}
+
+	for _, arg := range command.Args {
+		s.scanArg(arg, scope)
+	}
+}
+
+func (s *templateUsageScanner) scanPipe(pipe *parse.PipeNode, scope templateScope) {
+	if pipe == nil {
+		return
+	}
+
+	for _, command := range pipe.Cmds {
+		s.scanCommand(command, scope)
+	}
+
+	s.scanRequired(pipe, scope)
+}
+
+// scanBlockPipe records the value the pipeline of a with or range block resolves to as used by the block, or the values
+// referenced within it if it can't be resolved.
+func (s *templateUsageScanner) scanBlockPipe(pipe *parse.PipeNode, scope templateScope) {
+	if reference := resolvePipe(pipe, scope); reference != nil {
+		s.record(reference, pipe, true)
+		return
+	}
+
+	s.scanPipe(pipe, scope)
+}
+
+func (s *templateUsageScanner) scanList(list *parse.ListNode, scope templateScope) {
+	if list == nil {
+		return
+	}
+
+	for _, node := range list.Nodes {
+		s.scanNode(node, scope)
+	}
+}
+
+func (s *templateUsageScanner) scanNode(node parse.Node, scope templateScope) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		s.scanList(n, scope)
+	case *parse.ActionNode:
+		s.scanPipe(n.Pipe, scope)
+		for _, variable := range n.Pipe.Decl {
+			scope.variables[variable.Ident[0]] = resolvePipe(n.Pipe, scope)
+		}
+	case *parse.TextNode:
+		s.scanAPIVersions(n)
+		s.scanRBACResources(n)
+	case *parse.IfNode:
+		s.scanPipe(n.Pipe, scope)
+		s.scanFailGuard(n, scope)
+		conditions := s.conditionKeys(n.Pipe, scope)
+		s.scanConditionalList(n.List, scope.nested(scope.dot), n.Pipe, conditions)
+		s.scanConditionalList(n.ElseList, scope.nested(scope.dot), n.Pipe, conditions)
+	case *parse.WithNode:
+		s.scanBlockPipe(n.Pipe, scope)
+		conditions := s.conditionKeys(n.Pipe, scope)
+		reference := resolvePipe(n.Pipe, scope)
+		withScope := scope.nested(reference)
+		for _, variable := range n.Pipe.Decl {
+			withScope.variables[variable.Ident[0]] = reference
+		}
+		s.scanConditionalList(n.List, withScope, n.Pipe, conditions)
+		s.scanConditionalList(n.ElseList, scope.nested(scope.dot), n.Pipe, conditions)
+	case *parse.RangeNode:
+		s.scanBlockPipe(n.Pipe, scope)
+		item := resolvePipe(n.Pipe, scope).withSegments("[]")
+		rangeScope := scope.nested(item)
+		for i, variable := range n.Pipe.Decl {
+			// The first of two variables is the index or key of the item
+			if i == len(n.Pipe.Decl)-1 {
+				rangeScope.variables[variable.Ident[0]] = item
+			} else {
+				rangeScope.variables[variable.Ident[0]] = nil
+			}
+		}
+		s.scanList(n.List, rangeScope)
+		s.scanList(n.ElseList, scope.nested(scope.dot))
+	case *parse.TemplateNode:
+		s.scanPipe(n.Pipe, scope)
+	}
+}
+
+// scanTemplateFile scans a template file for the values it references and requires, the values its RBAC resources are
+// conditional on, and the API versions it creates resources of. The dot of the file and of each template defined in it
+// is assumed to be the root context of the chart, which is how templates are almost always included.
+func scanTemplateFile(contents string, relativePath string) (*templateUsageScanner, error) {
+	tree := parse.New(relativePath)
+	tree.Mode = parse.SkipFuncCheck
+	trees := make(map[string]*parse.Tree)
+	if _, err := tree.Parse(contents, "", "", trees); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(trees))
+	for name := range trees {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	scanner := &templateUsageScanner{file: relativePath, contents: contents}
+	for _, name := range names {
+		root := &templateReference{}
+		scanner.scanList(trees[name].Root, templateScope{dot: root, variables: map[string]*templateReference{"$": root}})
+	}
+
+	return scanner, nil
+}
+
+// chartTemplatesScan is what scanning the templates of a chart finds.
+type chartTemplatesScan struct {
+	// The values referenced by the templates, sorted by file and line
+	usages []ValueUsage
+
+	// The values required by the templates, sorted by key with the first place each is required at
+	requiredValues []RequiredValue
+
+	// The roles and bindings written out in each template, keyed by the path of the template
+	rbacResources map[string][]rbacTemplateResource
+
+	// The API versions the templates create resources of, sorted by file and line
+	apiUsages []KubernetesAPIUsage
+}
+
+// scanChartTemplates scans the templates of a chart. Templates which can't be parsed are reported as warnings and left
+// out.
+func scanChartTemplates(chartDirectory string) chartTemplatesScan {
+	usages := make([]ValueUsage, 0)
+	requiredValues := make([]RequiredValue, 0)
+	rbacResources := make(map[string][]rbacTemplateResource)
+	apiUsages := make([]KubernetesAPIUsage, 0)
+	templatesDirectory := filepath.Join(chartDirectory, "templates")
+
+	err := filepath.Walk(templatesDirectory, func(path string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+
+			return err
+		}
+
+		if fileInfo.IsDir() {
+			return nil
+		}
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			log.Warnf("Failed to read template file %s: %s", path, err)
+			return nil
+		}
+
+		relativePath, _ := filepath.Rel(chartDirectory, path)
+		scanner, err := scanTemplateFile(string(contents), filepath.ToSlash(relativePath))
+		if err != nil {
+			log.Warnf("Failed to parse template file %s: %s", path, err)
+			return nil
+		}
+
+		usages = append(usages, scanner.usages...)
+		requiredValues = append(requiredValues, scanner.requiredValues...)
+		rbacResources[scanner.file] = scanner.rbacResources
+		apiUsages = append(apiUsages, scanner.apiUsages...)
+		return nil
+	})
+
+	if err != nil {
+		log.Warnf("Failed to read the templates of chart %s: %s", chartDirectory, err)
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		if usages[i].File != usages[j].File {
+			return usages[i].File < usages[j].File
+		}
+
+		if usages[i].Line != usages[j].Line {
+			return usages[i].Line < usages[j].Line
+		}
+
+		return usages[i].Key < usages[j].Key
+	})
+
+	uniqueUsages := make([]ValueUsage, 0, len(usages))
+	for i, usage := range usages {
+		if i == 0 || usage != usages[i-1] {
+			uniqueUsages = append(uniqueUsages, usage)
+		}
+	}
+
+	// Files are walked in lexical order, so the first occurrence of a key is the first place it's required at
+	sort.SliceStable(requiredValues, func(i, j int) bool {
+		if requiredValues[i].File != requiredValues[j].File {
+			return requiredValues[i].File < requiredValues[j].File
+		}
+
+		return requiredValues[i].Line < requiredValues[j].Line
+	})
+
+	uniqueRequiredValues := make([]RequiredValue, 0, len(requiredValues))
+	requiredKeys := make(map[string]bool)
+	for _, requiredValue := range requiredValues {
+		if !requiredKeys[requiredValue.Key] {
+			requiredKeys[requiredValue.Key] = true
+			uniqueRequiredValues = append(uniqueRequiredValues, requiredValue)
+		}
+	}
+
+	sort.SliceStable(uniqueRequiredValues, func(i, j int) bool {
+		return uniqueRequiredValues[i].Key < uniqueRequiredValues[j].Key
+	})
+
+	sort.SliceStable(apiUsages, func(i, j int) bool {
+		if apiUsages[i].File != apiUsages[j].File {
+			return apiUsages[i].File < apiUsages[j].File
+		}
+
+		return apiUsages[i].Line < apiUsages[j].Line
+	})
+
+	return chartTemplatesScan{
+		usages:         uniqueUsages,
+		requiredValues: uniqueRequiredValues,
+		rbacResources:  rbacResources,
+		apiUsages:      apiUsages,
+	}
+}
@@ -0,0 +1,61 @@
+package helm_test
+
+import (
+	"path/filepath"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestParseValueUsages() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "template-usage"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.ValueUsage{
+		{Key: "replicaCount", File: "templates/NOTES.txt", Line: 1},
+		{Key: "fullnameOverride", File: "templates/_helpers.tpl", Line: 2},
+		{Key: "replicaCount", File: "templates/deployment.yaml", Line: 6},
+		{Key: "nodeSelector", File: "templates/deployment.yaml", Line: 9, Block: true},
+		{Key: "nodeSelector", File: "templates/deployment.yaml", Line: 10},
+		{Key: "image.repository", File: "templates/deployment.yaml", Line: 13},
+		{Key: "image.tag", File: "templates/deployment.yaml", Line: 13},
+		{Key: "env", File: "templates/deployment.yaml", Line: 14, Block: true},
+		{Key: "env[].text", File: "templates/deployment.yaml", Line: 17},
+		{Key: "probes", File: "templates/deployment.yaml", Line: 19, Block: true},
+		{Key: "probes.liveness.port", File: "templates/deployment.yaml", Line: 22},
+		{Key: `"config.d"."log.level"`, File: "templates/deployment.yaml", Line: 25},
+		{Key: "args[0]", File: "templates/deployment.yaml", Line: 26},
+		{Key: "auth.token", File: "templates/deployment.yaml", Line: 27},
+		{Key: "service", File: "templates/deployment.yaml", Line: 28},
+		{Key: "service.enabled", File: "templates/deployment.yaml", Line: 29},
+		{Key: "service.ports", File: "templates/deployment.yaml", Line: 30, Block: true},
+		{Key: "service.ports[].containerPort", File: "templates/deployment.yaml", Line: 31},
+		{Key: "tests.image", File: "templates/tests/test.yaml", Line: 1},
+	}, info.ValueUsages)
+}
+
+func (suite *ChartParsingTestSuite) TestInvalidTemplate() {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "invalid-template"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Empty(info.ValueUsages)
+	suite.Require().Len(hook.AllEntries(), 1)
+	suite.Contains(hook.LastEntry().Message, "Failed to parse template file")
+}
+
+func (suite *ChartParsingTestSuite) TestParseRequiredValues() {
+	info, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "required-values"), helm.ChartValuesDocumentationParsingConfig{})
+	suite.Require().NoError(err)
+
+	suite.Equal([]helm.RequiredValue{
+		{Key: "auth.token", Message: "again", File: "templates/_helpers.tpl", Line: 1},
+		{Key: "database.host", Message: "database.host must be set", File: "templates/secret.yaml", Line: 1},
+		{Key: "database.password", File: "templates/secret.yaml", Line: 4},
+		{Key: "database.user", Message: "a user is required", File: "templates/secret.yaml", Line: 12},
+		{Key: "users[].name", Message: "every user needs a name", File: "templates/secret.yaml", Line: 10},
+	}, info.RequiredValues)
+}
@@ -0,0 +1,27 @@
+{{/* vim: set filetype=mustache: */}}
+
+{{/*
+Expand the name
+of the chart.
+*/}}
+{{- define "lib.name" -}}
+{{- default .Chart.Name .Values.nameOverride }}
+{{- end }}
+
+{{- /*
+Common labels.
+@param context -- (context) The context of the chart
+@param component -- Name of the
+  component
+@example
+  metadata:
+    labels:
+      {{- include "lib.labels" (dict "context" . "component" "server") | nindent 4 }}
+*/ -}}
+{{ define "lib.labels" }}
+app.kubernetes.io/component: {{ .component }}
+{{ end }}
+
+{{/* Not documenting the template, which doesn't directly follow it */}}
+{{ $unused := true }}
+{{ define "lib.undocumented" }}{{ end }}
@@ -0,0 +1,2 @@
+{{/* First */}}
+{{ define "lib.name" }}{{ end }}
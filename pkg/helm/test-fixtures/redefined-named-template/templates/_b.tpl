@@ -0,0 +1,2 @@
+{{/* Second */}}
+{{ define "lib.name" }}{{ end }}
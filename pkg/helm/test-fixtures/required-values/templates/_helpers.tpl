@@ -0,0 +1 @@
+{{ required "again" .Values.auth.token }}
@@ -0,0 +1,3 @@
+{{- define "app.fullname" -}}
+{{- default .Chart.Name .Values.fullnameOverride }}
+{{- end }}
@@ -0,0 +1,72 @@
+package helm
+
+import (
+	"strings"
+)
+
+// SplitValueKey splits the key of a value, in the format of the values table, into its segments, keeping quoted keys
+// containing dots together and list indices, or [] for any item, as segments of their own.
+func SplitValueKey(key string) []string {
+	segments := make([]string, 0)
+	var segment strings.Builder
+	quoted := false
+
+	for _, c := range key {
+		switch {
+		case c == '"':
+			quoted = !quoted
+			segment.WriteRune(c)
+		case quoted:
+			segment.WriteRune(c)
+		case c == '.':
+			segments = append(segments, segment.String())
+			segment.Reset()
+		case c == '[':
+			if segment.Len() > 0 {
+				segments = append(segments, segment.String())
+				segment.Reset()
+			}
+			segment.WriteRune(c)
+		default:
+			segment.WriteRune(c)
+		}
+	}
+
+	return append(segments, segment.String())
+}
+
+// JoinValueKey is the inverse of SplitValueKey.
+func JoinValueKey(segments []string) string {
+	var key strings.Builder
+	for i, segment := range segments {
+		if i > 0 && !strings.HasPrefix(segment, "[") {
+			key.WriteString(".")
+		}
+		key.WriteString(segment)
+	}
+
+	return key.String()
+}
+
+// ValueKeysMatch returns whether either of the keys with the given segments is nested in the other, or they're equal.
+// The [] segments of keys used within a range match any list index or map key.
+func ValueKeysMatch(keySegments []string, usageSegments []string) bool {
+	for i := 0; i < len(keySegments) && i < len(usageSegments); i++ {
+		if usageSegments[i] != "[]" && usageSegments[i] != keySegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UsesValue returns whether the usage refers to the value with the given key segments, or to a value containing it or
+// contained in it. Usages by with and range blocks only use the values containing them. The segments of the key of the
+// usage are passed in so that callers matching many values can split it once.
+func (usage ValueUsage) UsesValue(keySegments []string, usageSegments []string) bool {
+	if usage.Block && len(keySegments) > len(usageSegments) {
+		return false
+	}
+
+	return ValueKeysMatch(keySegments, usageSegments)
+}
@@ -0,0 +1,31 @@
+package helm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func TestSplitValueKey(t *testing.T) {
+	assert.Equal(t, []string{"controller", "image", "tag"}, helm.SplitValueKey("controller.image.tag"))
+	assert.Equal(t, []string{"hosts", "[0]", "paths", "[1]"}, helm.SplitValueKey("hosts[0].paths[1]"))
+	assert.Equal(t, []string{"hosts", "[]", "host"}, helm.SplitValueKey("hosts[].host"))
+	assert.Equal(t, []string{"annotations", `"external-dns.alpha.kubernetes.io/hostname"`}, helm.SplitValueKey(`annotations."external-dns.alpha.kubernetes.io/hostname"`))
+	assert.Equal(t, "hosts[0].paths[1]", helm.JoinValueKey(helm.SplitValueKey("hosts[0].paths[1]")))
+}
+
+func TestValueUsageUsesValue(t *testing.T) {
+	usage := helm.ValueUsage{Key: "ingress.hosts[].host"}
+	usageSegments := helm.SplitValueKey(usage.Key)
+
+	assert.True(t, usage.UsesValue(helm.SplitValueKey("ingress"), usageSegments))
+	assert.True(t, usage.UsesValue(helm.SplitValueKey("ingress.hosts[1].host"), usageSegments))
+	assert.True(t, usage.UsesValue(helm.SplitValueKey("ingress.hosts[1].host.name"), usageSegments))
+	assert.False(t, usage.UsesValue(helm.SplitValueKey("ingress.hosts[1].paths"), usageSegments))
+
+	block := helm.ValueUsage{Key: "ingress.hosts", Block: true}
+	assert.True(t, block.UsesValue(helm.SplitValueKey("ingress.hosts"), helm.SplitValueKey(block.Key)))
+	assert.False(t, block.UsesValue(helm.SplitValueKey("ingress.hosts[0]"), helm.SplitValueKey(block.Key)))
+}
@@ -0,0 +1,231 @@
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/norwoodj/helm-docs/pkg/util"
+)
+
+// parseValuesDocsFile reads the optional values documentation sidecar file of a chart. The file maps value paths, as
+// they appear in the values table, to their documentation.
+func parseValuesDocsFile(chartDirectory string) (map[string]ChartValueDescription, error) {
+	return parseValuesDocsFileForLanguage(chartDirectory, "")
+}
+
+// parseValuesDocsTranslations reads the values documentation sidecar files of each of the configured languages, e.g.
+// values.docs.de.yaml, and returns the translated descriptions they contain, keyed by language and then value path.
+func parseValuesDocsTranslations(chartDirectory string) (map[string]map[string]string, error) {
+	translations := make(map[string]map[string]string)
+
+	for _, language := range viper.GetStringSlice("languages") {
+		valuesDocs, err := parseValuesDocsFileForLanguage(chartDirectory, language)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, description := range valuesDocs {
+			if description.Description == "" {
+				continue
+			}
+			if translations[language] == nil {
+				translations[language] = make(map[string]string)
+			}
+			translations[language][key] = description.Description
+		}
+	}
+
+	return translations, nil
+}
+
+func parseValuesDocsFileForLanguage(chartDirectory string, language string) (map[string]ChartValueDescription, error) {
+	valuesDocsFile := viper.GetString("values-docs-file")
+	if valuesDocsFile == "" {
+		return nil, nil
+	}
+
+	valuesDocsPath := filepath.Join(chartDirectory, util.LanguageFilePath(valuesDocsFile, language))
+	yamlFileContents, err := getYamlFileContents(valuesDocsPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	valuesDocs := make(map[string]ChartValueDescription)
+	if err := yaml.Unmarshal(yamlFileContents, &valuesDocs); err != nil {
+		return nil, fmt.Errorf("failed to parse values documentation file %s: %w", valuesDocsPath, err)
+	}
+
+	log.Debugf("Found values documentation file %s with %d entries", valuesDocsPath, len(valuesDocs))
+	return valuesDocs, nil
+}
+
+// mergeValuesDocsFile merges the entries of the chart's values documentation sidecar file into the descriptions parsed
+// from values file comments. Sidecar entries take precedence, and any disagreement with an inline comment is reported.
+func mergeValuesDocsFile(chartDirectory string, values *yaml.Node, keyToDescriptions map[string]ChartValueDescription) error {
+	valuesDocs, err := parseValuesDocsFile(chartDirectory)
+	if err != nil || len(valuesDocs) == 0 {
+		return err
+	}
+
+	inlineDescriptions := GetInlineValueDescriptions(values, keyToDescriptions)
+
+	for key, sidecarDescription := range valuesDocs {
+		inlineDescription := inlineDescriptions[key]
+		reportValuesDocsConflict(chartDirectory, key, "description", inlineDescription.Description, sidecarDescription.Description)
+		reportValuesDocsConflict(chartDirectory, key, "type", inlineDescription.ValueType, sidecarDescription.ValueType)
+		reportValuesDocsConflict(chartDirectory, key, "default", inlineDescription.Default, sidecarDescription.Default)
+		reportValuesDocsConflict(chartDirectory, key, "section", inlineDescription.Section, sidecarDescription.Section)
+		reportValuesDocsConflict(chartDirectory, key, "notation type", inlineDescription.NotationType, sidecarDescription.NotationType)
+
+		keyToDescriptions[key] = mergeValueDescriptions(keyToDescriptions[key], sidecarDescription)
+	}
+
+	return nil
+}
+
+func reportValuesDocsConflict(chartDirectory string, key string, field string, inlineValue string, sidecarValue string) {
+	if inlineValue == "" || sidecarValue == "" || inlineValue == sidecarValue {
+		return
+	}
+
+	log.Warnf(
+		"Conflicting %s for value %s in chart %s: comment in %s says %q, %s says %q. Using the latter",
+		field,
+		key,
+		chartDirectory,
+		viper.GetString("values-file"),
+		inlineValue,
+		viper.GetString("values-docs-file"),
+		sidecarValue,
+	)
+}
+
+// mergeValueDescriptions overlays every field that is set in override onto base.
+func mergeValueDescriptions(base ChartValueDescription, override ChartValueDescription) ChartValueDescription {
+	if override.Description != "" {
+		base.Description = override.Description
+	}
+	if override.Default != "" {
+		base.Default = override.Default
+	}
+	if override.Section != "" {
+		base.Section = override.Section
+	}
+	if override.ValueType != "" {
+		base.ValueType = override.ValueType
+	}
+	if override.NotationType != "" {
+		base.NotationType = override.NotationType
+	}
+	if override.Deprecated {
+		base.Deprecated = true
+		base.DeprecationMessage = override.DeprecationMessage
+	}
+	if len(override.See) > 0 {
+		base.See = override.See
+	}
+	if override.Example != "" {
+		base.Example = override.Example
+	}
+	if override.Image {
+		base.Image = true
+		base.ImageName = override.ImageName
+	}
+	if len(override.Translations) > 0 {
+		translations := make(map[string]string, len(base.Translations)+len(override.Translations))
+		for language, description := range base.Translations {
+			translations[language] = description
+		}
+		for language, description := range override.Translations {
+			translations[language] = description
+		}
+		base.Translations = translations
+	}
+
+	return base
+}
+
+// GetInlineValueDescriptions returns the documentation of every value that is documented by a comment in the values
+// file, whether with an old-style comment naming the value's path, or a new-style comment preceding the value.
+func GetInlineValueDescriptions(values *yaml.Node, keyToDescriptions map[string]ChartValueDescription) map[string]ChartValueDescription {
+	inlineDescriptions := make(map[string]ChartValueDescription)
+
+	if values.Kind == yaml.DocumentNode && len(values.Content) > 0 {
+		collectInlineValueDescriptions("", values.Content[0], inlineDescriptions)
+	}
+
+	for key, description := range keyToDescriptions {
+		inlineDescriptions[key] = mergeValueDescriptions(inlineDescriptions[key], description)
+	}
+
+	return inlineDescriptions
+}
+
+func collectInlineValueDescriptions(prefix string, node *yaml.Node, inlineDescriptions map[string]ChartValueDescription) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			key := formatValuePath(prefix, keyNode.Value)
+			addHeadCommentDescription(key, keyNode, inlineDescriptions)
+			collectInlineValueDescriptions(key, valueNode, inlineDescriptions)
+		}
+	case yaml.SequenceNode:
+		for i, itemNode := range node.Content {
+			key := fmt.Sprintf("%s[%d]", prefix, i)
+			addHeadCommentDescription(key, itemNode, inlineDescriptions)
+			collectInlineValueDescriptions(key, itemNode, inlineDescriptions)
+		}
+	case yaml.AliasNode:
+		collectInlineValueDescriptions(prefix, node.Alias, inlineDescriptions)
+	}
+}
+
+func addHeadCommentDescription(key string, node *yaml.Node, inlineDescriptions map[string]ChartValueDescription) {
+	if !strings.Contains(node.HeadComment, PrefixComment) {
+		return
+	}
+
+	keyFromComment, description := ParseComment(strings.Split(node.HeadComment, "\n"))
+	if keyFromComment == "" {
+		inlineDescriptions[key] = description
+	}
+}
+
+// RenderValuesDocsFile renders the documentation of every documented value of a chart, including entries from an
+// existing sidecar file, in the format of the values documentation sidecar file.
+func RenderValuesDocsFile(info ChartDocumentationInfo) ([]byte, error) {
+	valuesDocs := GetInlineValueDescriptions(info.ChartValues, info.ChartValuesDescriptions)
+
+	// Annotation lines such as "# @default -- ..." look like old-style comments for a key named after the annotation
+	for key := range valuesDocs {
+		if strings.HasPrefix(key, "@") {
+			delete(valuesDocs, key)
+		}
+	}
+
+	var output bytes.Buffer
+	encoder := yaml.NewEncoder(&output)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(valuesDocs); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
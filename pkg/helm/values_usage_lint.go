@@ -0,0 +1,183 @@
+package helm
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// isValueDefined returns whether the value at the given key segments is set in the values, or may be set in a list or
+// map of the values, which is the case for [] segments and for values nested in null values.
+func isValueDefined(node *yaml.Node, segments []string) bool {
+	node = resolveAlias(node)
+	if len(segments) == 0 || node.Tag == "!!null" {
+		return true
+	}
+
+	if segments[0] == "[]" {
+		return node.Kind == yaml.SequenceNode || node.Kind == yaml.MappingNode
+	}
+
+	if strings.HasPrefix(segments[0], "[") {
+		index, err := strconv.Atoi(strings.Trim(segments[0], "[]"))
+		if err != nil || node.Kind != yaml.SequenceNode || index >= len(node.Content) {
+			return false
+		}
+
+		return isValueDefined(node.Content[index], segments[1:])
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		if formatValueKeySegment(node.Content[i].Value) == segments[0] {
+			return isValueDefined(node.Content[i+1], segments[1:])
+		}
+	}
+
+	return false
+}
+
+// isValueKeyUsed returns whether any of the usages uses the value.
+func isValueKeyUsed(segments []string, usages []ValueUsage, usageSegments [][]string) bool {
+	for j, usage := range usages {
+		if usage.UsesValue(segments, usageSegments[j]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectUnusedValues returns the positions of the values which aren't used and aren't ignored, either in strict mode or
+// with @ignore, reporting only the outermost value of an unused object or list.
+func collectUnusedValues(node *yaml.Node, segments []string, usages []ValueUsage, usageSegments [][]string, valuesFile string, config ChartValuesDocumentationParsingConfig) []string {
+	unusedValues := make([]string, 0)
+	node = resolveAlias(node)
+
+	// Values marked @ignore are left out of the documentation, and aren't reported either
+	var children []*yaml.Node
+	var childSegments []string
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			if !strings.Contains(node.Content[i].HeadComment, "@ignore") {
+				children = append(children, node.Content[i+1])
+				childSegments = append(childSegments, formatValueKeySegment(node.Content[i].Value))
+			}
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			if !strings.Contains(child.HeadComment, "@ignore") {
+				children = append(children, child)
+				childSegments = append(childSegments, fmt.Sprintf("[%d]", i))
+			}
+		}
+	}
+
+	for i, child := range children {
+		keySegments := append(append([]string{}, segments...), childSegments[i])
+		key := JoinValueKey(keySegments)
+		if !isValueKeyUsed(keySegments, usages, usageSegments) {
+			if !isIgnoredValuePath(key, config) {
+				unusedValues = append(unusedValues, fmt.Sprintf("%s (%s:%d)", key, valuesFile, child.Line))
+			}
+			continue
+		}
+
+		unusedValues = append(unusedValues, collectUnusedValues(child, keySegments, usages, usageSegments, valuesFile, config)...)
+	}
+
+	return unusedValues
+}
+
+// getDependencyValueUsages returns the usages of the values which are used by the dependencies of a chart rather than
+// its templates: the values of each dependency, the global values passed to all of them, and their conditions and tags.
+func getDependencyValueUsages(requirements ChartRequirements) []ValueUsage {
+	usages := make([]ValueUsage, 0)
+	if len(requirements.Dependencies) == 0 {
+		return usages
+	}
+
+	usages = append(usages, ValueUsage{Key: "global"})
+	for _, dependency := range requirements.Dependencies {
+		usages = append(usages, ValueUsage{Key: formatValueKeySegment(dependency.GetName())})
+		for _, condition := range dependency.GetConditions() {
+			usages = append(usages, ValueUsage{Key: condition})
+		}
+
+		for _, tag := range dependency.Tags {
+			usages = append(usages, ValueUsage{Key: JoinValueKey([]string{"tags", formatValueKeySegment(tag)})})
+		}
+	}
+
+	return usages
+}
+
+// checkValueUsages checks the values used by the templates of a chart against its values file, failing if templates use
+// values which aren't set in the values file, or if values in the values file are used by neither a template nor a
+// dependency. Values marked @ignore count as set in the values file.
+func checkValueUsages(chartDirectory string, usages []ValueUsage, requirements ChartRequirements, config ChartValuesDocumentationParsingConfig) error {
+	valuesFile := viper.GetString("values-file")
+	yamlFileContents, err := getYamlFileContents(filepath.Join(chartDirectory, valuesFile))
+	if err != nil {
+		return err
+	}
+
+	var values yaml.Node
+	if err := yaml.Unmarshal(yamlFileContents, &values); err != nil {
+		return err
+	}
+
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if len(values.Content) > 0 {
+		root = values.Content[0]
+	}
+
+	dependencyNames := make(map[string]bool)
+	for _, dependency := range requirements.Dependencies {
+		dependencyNames[formatValueKeySegment(dependency.GetName())] = true
+	}
+
+	missingValues := make([]string, 0)
+	for _, usage := range usages {
+		segments := SplitValueKey(usage.Key)
+
+		// Values of dependencies are defined in the values files of the dependencies
+		if isValueDefined(root, segments) || dependencyNames[segments[0]] || isIgnoredValuePath(usage.Key, config) {
+			continue
+		}
+
+		missingValues = append(missingValues, fmt.Sprintf("%s (%s:%d)", usage.Key, usage.File, usage.Line))
+	}
+
+	// Exported values are used by the charts importing them
+	allUsages := append(append([]ValueUsage{{Key: "exports"}}, usages...), getDependencyValueUsages(requirements)...)
+	usageSegments := make([][]string, len(allUsages))
+	for i, usage := range allUsages {
+		usageSegments[i] = SplitValueKey(usage.Key)
+	}
+
+	unusedValues := collectUnusedValues(root, nil, allUsages, usageSegments, valuesFile, config)
+
+	var problems []string
+	if len(missingValues) > 0 {
+		problems = append(problems, fmt.Sprintf("values used in templates but missing from the values file: \n%s", strings.Join(missingValues, "\n")))
+	}
+
+	if len(unusedValues) > 0 {
+		problems = append(problems, fmt.Sprintf("values not used by any template or dependency: \n%s", strings.Join(unusedValues, "\n")))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
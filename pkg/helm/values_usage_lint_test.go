@@ -0,0 +1,37 @@
+package helm_test
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/norwoodj/helm-docs/pkg/helm"
+)
+
+func (suite *ChartParsingTestSuite) TestCheckValueUsages() {
+	_, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "value-usage-lint"), helm.ChartValuesDocumentationParsingConfig{StrictTemplateValues: true})
+
+	suite.Require().Error(err)
+	suite.Equal("values used in templates but missing from the values file: \n"+
+		"replicaCount (templates/deployment.yaml:6)\n"+
+		"service.port (templates/deployment.yaml:7)\n"+
+		"values not used by any template or dependency: \n"+
+		"image.pullPolicy (values.yaml:4)\n"+
+		"hosts[0].paths (values.yaml:7)\n"+
+		"legacy (values.yaml:10)", err.Error())
+}
+
+func (suite *ChartParsingTestSuite) TestCheckValueUsagesIgnored() {
+	_, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "value-usage-lint"), helm.ChartValuesDocumentationParsingConfig{
+		StrictTemplateValues:       true,
+		AllowedMissingValuePaths:   []string{"replicaCount", "legacy"},
+		AllowedMissingValueRegexps: []*regexp.Regexp{regexp.MustCompile(`^service\.`), regexp.MustCompile(`.*\.pullPolicy`), regexp.MustCompile(`^hosts`)},
+	})
+
+	suite.Require().NoError(err)
+}
+
+func (suite *ChartParsingTestSuite) TestCheckValueUsagesDisabled() {
+	_, err := helm.ParseChartInformation(filepath.Join("test-fixtures", "value-usage-lint"), helm.ChartValuesDocumentationParsingConfig{})
+
+	suite.Require().NoError(err)
+}
@@ -1,6 +1,9 @@
 package util
 
-import "path"
+import (
+	"path"
+	"strings"
+)
 
 func IsRelativePath(filePath string) bool {
 	return (filePath[0] == '.') && path.Base(filePath) != filePath
@@ -9,3 +12,14 @@ func IsRelativePath(filePath string) bool {
 func IsBaseFilename(filePath string) bool {
 	return path.Base(filePath) == filePath
 }
+
+// LanguageFilePath returns the variant of filePath for the given language, inserting the language before the file's
+// extension, e.g. README.md becomes README.de.md.
+func LanguageFilePath(filePath string, language string) string {
+	if language == "" {
+		return filePath
+	}
+
+	extension := path.Ext(filePath)
+	return strings.TrimSuffix(filePath, extension) + "." + language + extension
+}
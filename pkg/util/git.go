@@ -1,7 +1,9 @@
 package util
 
 import (
+	"fmt"
 	"os/exec"
+	"path/filepath"
 	"strings"
 )
 
@@ -14,3 +16,31 @@ func FindGitRepositoryRoot() (string, error) {
 
 	return strings.TrimSpace(string(path)), nil
 }
+
+func VerifyGitRevision(revision string) error {
+	if err := exec.Command("git", "rev-parse", "--quiet", "--verify", revision+"^{commit}").Run(); err != nil {
+		return fmt.Errorf("%s is not a valid git revision: %w", revision, err)
+	}
+
+	return nil
+}
+
+// GitFileExistsAtRevision reports whether filePath, relative to the working directory, was tracked at the given revision.
+func GitFileExistsAtRevision(revision string, filePath string) bool {
+	return exec.Command("git", "-C", filepath.Dir(filePath), "cat-file", "-e", gitObjectName(revision, filePath)).Run() == nil
+}
+
+// ReadGitFileAtRevision returns the contents of filePath, relative to the working directory, as of the given revision.
+func ReadGitFileAtRevision(revision string, filePath string) ([]byte, error) {
+	contents, err := exec.Command("git", "-C", filepath.Dir(filePath), "show", gitObjectName(revision, filePath)).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at revision %s: %w", filePath, revision, err)
+	}
+
+	return contents, nil
+}
+
+// gitObjectName uses the ./ form of a revision path, which git resolves relative to the directory it's run from.
+func gitObjectName(revision string, filePath string) string {
+	return fmt.Sprintf("%s:./%s", revision, filepath.ToSlash(filepath.Base(filePath)))
+}